@@ -0,0 +1,167 @@
+package proj
+
+// #include <stdlib.h>
+// #include "go-proj.h"
+// #cgo nocallback proj_alter_id
+// #cgo nocallback proj_alter_name
+// #cgo nocallback proj_crs_alter_cs_angular_unit
+// #cgo nocallback proj_crs_alter_cs_linear_unit
+// #cgo nocallback proj_crs_alter_geodetic_crs
+// #cgo nocallback proj_crs_alter_parameters_linear_unit
+// #cgo nocallback proj_errno_reset
+// #cgo nocallback proj_errno_restore
+// #cgo noescape proj_alter_id
+// #cgo noescape proj_alter_name
+// #cgo noescape proj_crs_alter_cs_angular_unit
+// #cgo noescape proj_crs_alter_cs_linear_unit
+// #cgo noescape proj_crs_alter_geodetic_crs
+// #cgo noescape proj_crs_alter_parameters_linear_unit
+// #cgo noescape proj_errno_reset
+// #cgo noescape proj_errno_restore
+import "C"
+
+import (
+	"unsafe"
+)
+
+// A Unit is a unit of measure.
+type Unit struct {
+	Name       string
+	ConvFactor float64
+	AuthName   string
+	Code       string
+}
+
+// Units.
+var (
+	UnitMeter        = Unit{Name: "metre", ConvFactor: 1, AuthName: "EPSG", Code: "9001"}
+	UnitFoot         = Unit{Name: "foot", ConvFactor: 0.3048, AuthName: "EPSG", Code: "9002"}
+	UnitUSSurveyFoot = Unit{Name: "US survey foot", ConvFactor: 0.304800609601219, AuthName: "EPSG", Code: "9003"}
+	UnitDegree       = Unit{Name: "degree", ConvFactor: 0.0174532925199433, AuthName: "EPSG", Code: "9122"}
+	UnitGrad         = Unit{Name: "grad", ConvFactor: 0.015707963267949, AuthName: "EPSG", Code: "9105"}
+	UnitRadian       = Unit{Name: "radian", ConvFactor: 1, AuthName: "EPSG", Code: "9101"}
+)
+
+// AlterCSAngularUnit returns a copy of pj, which must be a geographic CRS or a
+// CRS based on one, with the angular unit of its coordinate system set to
+// unit.
+func (pj *PJ) AlterCSAngularUnit(unit Unit) (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	cUnit := newCUnit(unit)
+	defer cUnit.free()
+
+	return pj.context.newPJ(C.proj_crs_alter_cs_angular_unit(pj.context.cPJContext, pj.cPJ, cUnit.name, cUnit.convFactor, cUnit.authName, cUnit.code))
+}
+
+// AlterCSLinearUnit returns a copy of pj, which must be a projected, geocentric,
+// vertical, or compound CRS, with the linear unit of its coordinate system set
+// to unit.
+func (pj *PJ) AlterCSLinearUnit(unit Unit) (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	cUnit := newCUnit(unit)
+	defer cUnit.free()
+
+	return pj.context.newPJ(C.proj_crs_alter_cs_linear_unit(pj.context.cPJContext, pj.cPJ, cUnit.name, cUnit.convFactor, cUnit.authName, cUnit.code))
+}
+
+// AlterGeodeticCRS returns a copy of pj with its geodetic CRS replaced by
+// geodeticCRS. If pj is itself a geodetic CRS then a copy of geodeticCRS is
+// returned.
+func (pj *PJ) AlterGeodeticCRS(geodeticCRS *PJ) (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	defer pj.context.lockPJContexts(geodeticCRS)()
+	return pj.context.newPJ(C.proj_crs_alter_geodetic_crs(pj.context.cPJContext, pj.cPJ, geodeticCRS.cPJ))
+}
+
+// AlterID returns a copy of pj with its identifier set to authName and code.
+func (pj *PJ) AlterID(authName, code string) (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	cAuthName := C.CString(authName)
+	defer C.free(unsafe.Pointer(cAuthName))
+
+	cCode := C.CString(code)
+	defer C.free(unsafe.Pointer(cCode))
+
+	return pj.context.newPJ(C.proj_alter_id(pj.context.cPJContext, pj.cPJ, cAuthName, cCode))
+}
+
+// AlterName returns a copy of pj with its name set to name.
+func (pj *PJ) AlterName(name string) (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	return pj.context.newPJ(C.proj_alter_name(pj.context.cPJContext, pj.cPJ, cName))
+}
+
+// AlterParametersLinearUnit returns a copy of pj, which must be a projected
+// CRS, with the linear unit of its conversion parameters set to unit. If
+// convert is true then the parameter values are converted to unit, otherwise
+// they are kept unchanged.
+func (pj *PJ) AlterParametersLinearUnit(unit Unit, convert bool) (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	cUnit := newCUnit(unit)
+	defer cUnit.free()
+
+	var cConvert C.int
+	if convert {
+		cConvert = 1
+	}
+
+	return pj.context.newPJ(C.proj_crs_alter_parameters_linear_unit(pj.context.cPJContext, pj.cPJ, cUnit.name, cUnit.convFactor, cUnit.authName, cUnit.code, cConvert))
+}
+
+// A cUnit is a Unit converted to C.
+type cUnit struct {
+	name       *C.char
+	convFactor C.double
+	authName   *C.char
+	code       *C.char
+}
+
+// newCUnit returns unit converted to C. The caller must call free.
+func newCUnit(unit Unit) cUnit {
+	return cUnit{
+		name:       C.CString(unit.Name),
+		convFactor: C.double(unit.ConvFactor),
+		authName:   cStringOrNil(unit.AuthName),
+		code:       cStringOrNil(unit.Code),
+	}
+}
+
+// free frees the memory used by u.
+func (u cUnit) free() {
+	C.free(unsafe.Pointer(u.name))
+	C.free(unsafe.Pointer(u.authName))
+	C.free(unsafe.Pointer(u.code))
+}
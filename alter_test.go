@@ -0,0 +1,141 @@
+package proj_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-proj/v11"
+)
+
+func TestPJ_AlterCSLinearUnit(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	crs, err := context.New("EPSG:2056")
+	assert.NoError(t, err)
+
+	alteredCRS, err := crs.AlterCSLinearUnit(proj.UnitUSSurveyFoot)
+	assert.NoError(t, err)
+
+	coordinateSystem, err := alteredCRS.CoordinateSystem()
+	assert.NoError(t, err)
+	for _, axis := range coordinateSystem.Axes {
+		assert.Equal(t, "US survey foot", axis.UnitName)
+		assertInDelta(t, proj.UnitUSSurveyFoot.ConvFactor, axis.UnitConvFactor, 1e-15)
+	}
+
+	originalCoordinateSystem, err := crs.CoordinateSystem()
+	assert.NoError(t, err)
+	for _, axis := range originalCoordinateSystem.Axes {
+		assert.Equal(t, "metre", axis.UnitName)
+	}
+}
+
+func TestPJ_AlterCSAngularUnit(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	crs, err := context.New("EPSG:4326")
+	assert.NoError(t, err)
+
+	alteredCRS, err := crs.AlterCSAngularUnit(proj.UnitGrad)
+	assert.NoError(t, err)
+
+	coordinateSystem, err := alteredCRS.CoordinateSystem()
+	assert.NoError(t, err)
+	for _, axis := range coordinateSystem.Axes {
+		assert.Equal(t, "grad", axis.UnitName)
+	}
+}
+
+func TestPJ_AlterParametersLinearUnit(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	crs, err := context.New("EPSG:2056")
+	assert.NoError(t, err)
+
+	for _, tc := range []struct {
+		name                      string
+		convert                   bool
+		expectedFalseEastingValue float64
+	}{
+		{
+			name:                      "convert",
+			convert:                   true,
+			expectedFalseEastingValue: 2600000 / proj.UnitUSSurveyFoot.ConvFactor,
+		},
+		{
+			name:                      "no_convert",
+			convert:                   false,
+			expectedFalseEastingValue: 2600000,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			alteredCRS, err := crs.AlterParametersLinearUnit(proj.UnitUSSurveyFoot, tc.convert)
+			assert.NoError(t, err)
+
+			conversion, err := alteredCRS.CoordOperation()
+			assert.NoError(t, err)
+
+			falseEasting, err := conversion.Param("False easting")
+			assert.NoError(t, err)
+			assert.Equal(t, "US survey foot", falseEasting.UnitName)
+			assertInDelta(t, tc.expectedFalseEastingValue, falseEasting.Value, 1e-6)
+		})
+	}
+}
+
+func TestPJ_AlterGeodeticCRS(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	crs, err := context.New("EPSG:32632")
+	assert.NoError(t, err)
+
+	geodeticCRS, err := context.New("EPSG:4258")
+	assert.NoError(t, err)
+
+	alteredCRS, err := crs.AlterGeodeticCRS(geodeticCRS)
+	assert.NoError(t, err)
+
+	baseCRS, err := alteredCRS.SourceCRS()
+	assert.NoError(t, err)
+	assert.Equal(t, "ETRS89", baseCRS.Name())
+
+	originalBaseCRS, err := crs.SourceCRS()
+	assert.NoError(t, err)
+	assert.Equal(t, "WGS 84", originalBaseCRS.Name())
+}
+
+func TestPJ_AlterNameAlterID(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	crs, err := context.New("EPSG:2056")
+	assert.NoError(t, err)
+
+	renamedCRS, err := crs.AlterName("Site grid")
+	assert.NoError(t, err)
+	assert.Equal(t, "Site grid", renamedCRS.Name())
+	assert.Equal(t, "CH1903+ / LV95", crs.Name())
+
+	reidentifiedCRS, err := renamedCRS.AlterID("ACME", "1234")
+	assert.NoError(t, err)
+	assert.Equal(t, "ACME", reidentifiedCRS.IDAuthName(0))
+	assert.Equal(t, "1234", reidentifiedCRS.IDCode(0))
+	assert.Equal(t, "Site grid", reidentifiedCRS.Name())
+	assert.Equal(t, "EPSG", crs.IDAuthName(0))
+}
@@ -12,6 +12,9 @@ package proj
 // #cgo nocallback proj_create_crs_to_crs
 // #cgo nocallback proj_create_crs_to_crs_from_pj
 // #cgo nocallback proj_destroy
+// #cgo nocallback proj_list_destroy
+// #cgo nocallback proj_list_get
+// #cgo nocallback proj_list_get_count
 // #cgo nocallback proj_log_level
 // #cgo noescape proj_context_create
 // #cgo noescape proj_context_destroy
@@ -23,11 +26,15 @@ package proj
 // #cgo noescape proj_create_crs_to_crs
 // #cgo noescape proj_create_crs_to_crs_from_pj
 // #cgo noescape proj_destroy
+// #cgo noescape proj_list_destroy
+// #cgo noescape proj_list_get
+// #cgo noescape proj_list_get_count
 // #cgo noescape proj_log_level
 import "C"
 
 import (
 	"runtime"
+	"slices"
 	"sync"
 	"unsafe"
 )
@@ -52,6 +59,8 @@ func init() {
 type Context struct {
 	mutex      sync.Mutex
 	cPJContext *C.PJ_CONTEXT
+	wgs84Once  sync.Once
+	wgs84      []*PJ
 }
 
 // NewContext returns a new Context.
@@ -180,6 +189,15 @@ func (c *Context) errnoString(errno int) string {
 	return C.GoString(C.proj_context_errno_string(c.cPJContext, (C.int)(errno)))
 }
 
+// lastError returns the last error in c, or ErrNotFound if there is no error.
+// The errno of c should be reset before the call whose error is returned.
+func (c *Context) lastError() error {
+	if errno := int(C.proj_context_errno(c.cPJContext)); errno != 0 {
+		return c.newError(errno)
+	}
+	return ErrNotFound
+}
+
 // newError returns a new error with number errno.
 func (c *Context) newError(errno int) *Error {
 	return &Error{
@@ -188,10 +206,28 @@ func (c *Context) newError(errno int) *Error {
 	}
 }
 
+// lockPJContexts locks the Contexts of pjs other than c, which must already be
+// locked, and returns a function that unlocks them. nil PJs are ignored.
+func (c *Context) lockPJContexts(pjs ...*PJ) func() {
+	lockedContexts := []*Context{c}
+	for _, pj := range pjs {
+		if pj == nil || slices.Contains(lockedContexts, pj.context) {
+			continue
+		}
+		pj.context.Lock()
+		lockedContexts = append(lockedContexts, pj.context)
+	}
+	return func() {
+		for _, context := range lockedContexts[1:] {
+			context.Unlock()
+		}
+	}
+}
+
 // newPJ returns a new PJ or an error.
 func (c *Context) newPJ(cPJ *C.PJ) (*PJ, error) {
 	if cPJ == nil {
-		return nil, c.newError(int(C.proj_context_errno(c.cPJContext)))
+		return nil, c.lastError()
 	}
 
 	pj := &PJ{
@@ -204,6 +240,38 @@ func (c *Context) newPJ(cPJ *C.PJ) (*PJ, error) {
 	return pj, nil
 }
 
+// newPJList returns the PJs in cList and destroys cList.
+func (c *Context) newPJList(cList *C.PJ_OBJ_LIST) ([]*PJ, error) {
+	defer C.proj_list_destroy(cList)
+	n := int(C.proj_list_get_count(cList))
+	pjs := make([]*PJ, n)
+	for i := range pjs {
+		pj, err := c.newPJ(C.proj_list_get(c.cPJContext, cList, C.int(i)))
+		if err != nil {
+			return nil, err
+		}
+		pjs[i] = pj
+	}
+	return pjs, nil
+}
+
+// wgs84CRSs returns the WGS 84 geographic 2D, geocentric, and geographic 3D
+// CRSs, creating them on the first call. c must be locked.
+func (c *Context) wgs84CRSs() []*PJ {
+	c.wgs84Once.Do(func() {
+		for _, definition := range []string{"EPSG:4326", "EPSG:4978", "EPSG:4979"} {
+			cDefinition := C.CString(definition)
+			wgs84CRS, err := c.newPJ(C.proj_create(c.cPJContext, cDefinition))
+			C.free(unsafe.Pointer(cDefinition))
+			if err != nil {
+				continue
+			}
+			c.wgs84 = append(c.wgs84, wgs84CRS)
+		}
+	})
+	return c.wgs84
+}
+
 // SetLogLevel sets the log level for the default context.
 func SetLogLevel(logLevel LogLevel) {
 	defaultContext.SetLogLevel(logLevel)
@@ -0,0 +1,612 @@
+package proj
+
+// #include <stdlib.h>
+// #include "go-proj.h"
+// #cgo nocallback proj_create_cartesian_2D_cs
+// #cgo nocallback proj_create_compound_crs
+// #cgo nocallback proj_create_conversion
+// #cgo nocallback proj_create_conversion_albers_equal_area
+// #cgo nocallback proj_create_conversion_azimuthal_equidistant
+// #cgo nocallback proj_create_conversion_equidistant_cylindrical
+// #cgo nocallback proj_create_conversion_lambert_azimuthal_equal_area
+// #cgo nocallback proj_create_conversion_lambert_conic_conformal_1sp
+// #cgo nocallback proj_create_conversion_lambert_conic_conformal_2sp
+// #cgo nocallback proj_create_conversion_mercator_variant_a
+// #cgo nocallback proj_create_conversion_mercator_variant_b
+// #cgo nocallback proj_create_conversion_oblique_stereographic
+// #cgo nocallback proj_create_conversion_orthographic
+// #cgo nocallback proj_create_conversion_polar_stereographic_variant_a
+// #cgo nocallback proj_create_conversion_polar_stereographic_variant_b
+// #cgo nocallback proj_create_conversion_stereographic
+// #cgo nocallback proj_create_conversion_transverse_mercator
+// #cgo nocallback proj_create_conversion_utm
+// #cgo nocallback proj_create_cs
+// #cgo nocallback proj_create_ellipsoidal_2D_cs
+// #cgo nocallback proj_create_engineering_crs
+// #cgo nocallback proj_create_geographic_crs
+// #cgo nocallback proj_create_projected_crs
+// #cgo nocallback proj_create_vertical_crs
+// #cgo nocallback proj_destroy
+// #cgo noescape proj_create_cartesian_2D_cs
+// #cgo noescape proj_create_compound_crs
+// #cgo noescape proj_create_conversion
+// #cgo noescape proj_create_conversion_albers_equal_area
+// #cgo noescape proj_create_conversion_azimuthal_equidistant
+// #cgo noescape proj_create_conversion_equidistant_cylindrical
+// #cgo noescape proj_create_conversion_lambert_azimuthal_equal_area
+// #cgo noescape proj_create_conversion_lambert_conic_conformal_1sp
+// #cgo noescape proj_create_conversion_lambert_conic_conformal_2sp
+// #cgo noescape proj_create_conversion_mercator_variant_a
+// #cgo noescape proj_create_conversion_mercator_variant_b
+// #cgo noescape proj_create_conversion_oblique_stereographic
+// #cgo noescape proj_create_conversion_orthographic
+// #cgo noescape proj_create_conversion_polar_stereographic_variant_a
+// #cgo noescape proj_create_conversion_polar_stereographic_variant_b
+// #cgo noescape proj_create_conversion_stereographic
+// #cgo noescape proj_create_conversion_transverse_mercator
+// #cgo noescape proj_create_conversion_utm
+// #cgo noescape proj_create_cs
+// #cgo noescape proj_create_ellipsoidal_2D_cs
+// #cgo noescape proj_create_engineering_crs
+// #cgo noescape proj_create_geographic_crs
+// #cgo noescape proj_create_projected_crs
+// #cgo noescape proj_create_vertical_crs
+// #cgo noescape proj_destroy
+import "C"
+
+import (
+	"unsafe"
+)
+
+// A CartesianCS2DType is the type of a 2D Cartesian coordinate system.
+type CartesianCS2DType C.PJ_CARTESIAN_CS_2D_TYPE
+
+// 2D Cartesian coordinate system types.
+const (
+	CartesianCS2DTypeEastingNorthing                    CartesianCS2DType = C.PJ_CART2D_EASTING_NORTHING
+	CartesianCS2DTypeNorthingEasting                    CartesianCS2DType = C.PJ_CART2D_NORTHING_EASTING
+	CartesianCS2DTypeNorthPoleEastingSouthNorthingSouth CartesianCS2DType = C.PJ_CART2D_NORTH_POLE_EASTING_SOUTH_NORTHING_SOUTH
+	CartesianCS2DTypeSouthPoleEastingNorthNorthingNorth CartesianCS2DType = C.PJ_CART2D_SOUTH_POLE_EASTING_NORTH_NORTHING_NORTH
+	CartesianCS2DTypeWestingSouthing                    CartesianCS2DType = C.PJ_CART2D_WESTING_SOUTHING
+)
+
+// An EllipsoidalCS2DType is the type of a 2D ellipsoidal coordinate system.
+type EllipsoidalCS2DType C.PJ_ELLIPSOIDAL_CS_2D_TYPE
+
+// 2D ellipsoidal coordinate system types.
+const (
+	EllipsoidalCS2DTypeLongitudeLatitude EllipsoidalCS2DType = C.PJ_ELLPS2D_LONGITUDE_LATITUDE
+	EllipsoidalCS2DTypeLatitudeLongitude EllipsoidalCS2DType = C.PJ_ELLPS2D_LATITUDE_LONGITUDE
+)
+
+// A UnitType is the type of a unit.
+type UnitType C.PJ_UNIT_TYPE
+
+// Unit types.
+const (
+	UnitTypeAngular    UnitType = C.PJ_UT_ANGULAR
+	UnitTypeLinear     UnitType = C.PJ_UT_LINEAR
+	UnitTypeScale      UnitType = C.PJ_UT_SCALE
+	UnitTypeTime       UnitType = C.PJ_UT_TIME
+	UnitTypeParametric UnitType = C.PJ_UT_PARAMETRIC
+)
+
+// An AxisDescription describes an axis of a new coordinate system.
+type AxisDescription struct {
+	Name           string
+	Abbrev         string
+	Direction      string
+	UnitName       string
+	UnitConvFactor float64
+	UnitType       UnitType
+}
+
+// A GeographicCRSParameters contains the parameters of a new geographic CRS.
+// Angles are in degrees. If PrimeMeridianName is empty then Greenwich is used.
+// If CS is nil then a latitude, longitude coordinate system in degrees is used.
+type GeographicCRSParameters struct {
+	Name                string
+	DatumName           string
+	EllipsoidName       string
+	SemiMajorMeter      float64
+	InvFlattening       float64
+	PrimeMeridianName   string
+	PrimeMeridianOffset float64
+	CS                  *PJ
+}
+
+// A ParamDescription describes a parameter of a new conversion.
+type ParamDescription struct {
+	Name           string
+	AuthName       string
+	Code           string
+	Value          float64
+	UnitName       string
+	UnitConvFactor float64
+	UnitType       UnitType
+}
+
+// NewAlbersEqualAreaConversion returns a new Albers Equal Area conversion.
+// Angles are in degrees and distances in meters.
+func (c *Context) NewAlbersEqualAreaConversion(latFalseOrigin, lonFalseOrigin, latFirstParallel, latSecondParallel, eastingFalseOrigin, northingFalseOrigin float64) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+	return c.newPJ(C.proj_create_conversion_albers_equal_area(c.cPJContext,
+		C.double(latFalseOrigin), C.double(lonFalseOrigin), C.double(latFirstParallel), C.double(latSecondParallel),
+		C.double(eastingFalseOrigin), C.double(northingFalseOrigin),
+		nil, 0, nil, 0,
+	))
+}
+
+// NewAzimuthalEquidistantConversion returns a new Azimuthal Equidistant
+// conversion. Angles are in degrees and distances in meters.
+func (c *Context) NewAzimuthalEquidistantConversion(latNatOrigin, lonNatOrigin, falseEasting, falseNorthing float64) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+	return c.newPJ(C.proj_create_conversion_azimuthal_equidistant(c.cPJContext,
+		C.double(latNatOrigin), C.double(lonNatOrigin), C.double(falseEasting), C.double(falseNorthing),
+		nil, 0, nil, 0,
+	))
+}
+
+// NewCS returns a new coordinate system of type csType with axes.
+func (c *Context) NewCS(csType CoordinateSystemType, axes []AxisDescription) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	cAxes := make([]C.PJ_AXIS_DESCRIPTION, len(axes))
+	for i, axis := range axes {
+		cAxes[i].name = C.CString(axis.Name)
+		defer C.free(unsafe.Pointer(cAxes[i].name))
+		cAxes[i].abbreviation = C.CString(axis.Abbrev)
+		defer C.free(unsafe.Pointer(cAxes[i].abbreviation))
+		cAxes[i].direction = C.CString(axis.Direction)
+		defer C.free(unsafe.Pointer(cAxes[i].direction))
+		cAxes[i].unit_name = cStringOrNil(axis.UnitName)
+		defer C.free(unsafe.Pointer(cAxes[i].unit_name))
+		cAxes[i].unit_conv_factor = C.double(axis.UnitConvFactor)
+		cAxes[i].unit_type = C.PJ_UNIT_TYPE(axis.UnitType)
+	}
+
+	return c.newPJ(C.proj_create_cs(c.cPJContext, C.PJ_COORDINATE_SYSTEM_TYPE(csType), C.int(len(cAxes)), unsafe.SliceData(cAxes)))
+}
+
+// NewCartesianCS2D returns a new 2D Cartesian coordinate system in meters.
+func (c *Context) NewCartesianCS2D(csType CartesianCS2DType) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+	return c.newPJ(C.proj_create_cartesian_2D_cs(c.cPJContext, C.PJ_CARTESIAN_CS_2D_TYPE(csType), nil, 0))
+}
+
+// NewCompoundCRS returns a new compound CRS from horizontalCRS and
+// verticalCRS.
+func (c *Context) NewCompoundCRS(name string, horizontalCRS, verticalCRS *PJ) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+	defer c.lockPJContexts(horizontalCRS, verticalCRS)()
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	return c.newPJ(C.proj_create_compound_crs(c.cPJContext, cName, horizontalCRS.cPJ, verticalCRS.cPJ))
+}
+
+// NewConversion returns a new conversion using method with params.
+func (c *Context) NewConversion(name string, method MethodInfo, params []ParamDescription) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	cMethodName := C.CString(method.Name)
+	defer C.free(unsafe.Pointer(cMethodName))
+
+	cMethodAuthName := cStringOrNil(method.AuthName)
+	defer C.free(unsafe.Pointer(cMethodAuthName))
+
+	cMethodCode := cStringOrNil(method.Code)
+	defer C.free(unsafe.Pointer(cMethodCode))
+
+	cParams := make([]C.PJ_PARAM_DESCRIPTION, len(params))
+	for i, param := range params {
+		cParams[i].name = C.CString(param.Name)
+		defer C.free(unsafe.Pointer(cParams[i].name))
+		cParams[i].auth_name = cStringOrNil(param.AuthName)
+		defer C.free(unsafe.Pointer(cParams[i].auth_name))
+		cParams[i].code = cStringOrNil(param.Code)
+		defer C.free(unsafe.Pointer(cParams[i].code))
+		cParams[i].value = C.double(param.Value)
+		cParams[i].unit_name = cStringOrNil(param.UnitName)
+		defer C.free(unsafe.Pointer(cParams[i].unit_name))
+		cParams[i].unit_conv_factor = C.double(param.UnitConvFactor)
+		cParams[i].unit_type = C.PJ_UNIT_TYPE(param.UnitType)
+	}
+
+	return c.newPJ(C.proj_create_conversion(c.cPJContext,
+		cName, nil, nil,
+		cMethodName, cMethodAuthName, cMethodCode,
+		C.int(len(cParams)), unsafe.SliceData(cParams),
+	))
+}
+
+// NewEllipsoidalCS2D returns a new 2D ellipsoidal coordinate system in
+// degrees.
+func (c *Context) NewEllipsoidalCS2D(csType EllipsoidalCS2DType) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+	return c.newPJ(C.proj_create_ellipsoidal_2D_cs(c.cPJContext, C.PJ_ELLIPSOIDAL_CS_2D_TYPE(csType), nil, 0))
+}
+
+// NewEngineeringCRS returns a new engineering CRS.
+func (c *Context) NewEngineeringCRS(name string) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	return c.newPJ(C.proj_create_engineering_crs(c.cPJContext, cName))
+}
+
+// NewEquidistantCylindricalConversion returns a new Equidistant Cylindrical
+// conversion. Angles are in degrees and distances in meters.
+func (c *Context) NewEquidistantCylindricalConversion(latFirstParallel, lonNatOrigin, falseEasting, falseNorthing float64) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+	return c.newPJ(C.proj_create_conversion_equidistant_cylindrical(c.cPJContext,
+		C.double(latFirstParallel), C.double(lonNatOrigin), C.double(falseEasting), C.double(falseNorthing),
+		nil, 0, nil, 0,
+	))
+}
+
+// NewGeographicCRS returns a new geographic CRS with parameters.
+func (c *Context) NewGeographicCRS(parameters GeographicCRSParameters) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+	defer c.lockPJContexts(parameters.CS)()
+
+	cName := C.CString(parameters.Name)
+	defer C.free(unsafe.Pointer(cName))
+
+	cDatumName := C.CString(parameters.DatumName)
+	defer C.free(unsafe.Pointer(cDatumName))
+
+	cEllipsoidName := C.CString(parameters.EllipsoidName)
+	defer C.free(unsafe.Pointer(cEllipsoidName))
+
+	primeMeridianName := parameters.PrimeMeridianName
+	if primeMeridianName == "" {
+		primeMeridianName = "Greenwich"
+	}
+	cPrimeMeridianName := C.CString(primeMeridianName)
+	defer C.free(unsafe.Pointer(cPrimeMeridianName))
+
+	var cCS *C.PJ
+	if parameters.CS != nil {
+		cCS = parameters.CS.cPJ
+	} else {
+		cCS = C.proj_create_ellipsoidal_2D_cs(c.cPJContext, C.PJ_ELLPS2D_LATITUDE_LONGITUDE, nil, 0)
+		if cCS == nil {
+			return nil, c.lastError()
+		}
+		defer C.proj_destroy(cCS)
+	}
+
+	return c.newPJ(C.proj_create_geographic_crs(c.cPJContext,
+		cName, cDatumName, cEllipsoidName,
+		C.double(parameters.SemiMajorMeter), C.double(parameters.InvFlattening),
+		cPrimeMeridianName, C.double(parameters.PrimeMeridianOffset), nil, 0,
+		cCS,
+	))
+}
+
+// NewLambertAzimuthalEqualAreaConversion returns a new Lambert Azimuthal Equal
+// Area conversion. Angles are in degrees and distances in meters.
+func (c *Context) NewLambertAzimuthalEqualAreaConversion(latNatOrigin, lonNatOrigin, falseEasting, falseNorthing float64) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+	return c.newPJ(C.proj_create_conversion_lambert_azimuthal_equal_area(c.cPJContext,
+		C.double(latNatOrigin), C.double(lonNatOrigin), C.double(falseEasting), C.double(falseNorthing),
+		nil, 0, nil, 0,
+	))
+}
+
+// NewLambertConicConformal1SPConversion returns a new Lambert Conic Conformal
+// (1SP) conversion. Angles are in degrees and distances in meters.
+func (c *Context) NewLambertConicConformal1SPConversion(centerLat, centerLon, scale, falseEasting, falseNorthing float64) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+	return c.newPJ(C.proj_create_conversion_lambert_conic_conformal_1sp(c.cPJContext,
+		C.double(centerLat), C.double(centerLon), C.double(scale), C.double(falseEasting), C.double(falseNorthing),
+		nil, 0, nil, 0,
+	))
+}
+
+// NewLambertConicConformal2SPConversion returns a new Lambert Conic Conformal
+// (2SP) conversion. Angles are in degrees and distances in meters.
+func (c *Context) NewLambertConicConformal2SPConversion(latFalseOrigin, lonFalseOrigin, latFirstParallel, latSecondParallel, eastingFalseOrigin, northingFalseOrigin float64) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+	return c.newPJ(C.proj_create_conversion_lambert_conic_conformal_2sp(c.cPJContext,
+		C.double(latFalseOrigin), C.double(lonFalseOrigin), C.double(latFirstParallel), C.double(latSecondParallel),
+		C.double(eastingFalseOrigin), C.double(northingFalseOrigin),
+		nil, 0, nil, 0,
+	))
+}
+
+// NewMercatorVariantAConversion returns a new Mercator (variant A) conversion.
+// Angles are in degrees and distances in meters.
+func (c *Context) NewMercatorVariantAConversion(centerLat, centerLon, scale, falseEasting, falseNorthing float64) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+	return c.newPJ(C.proj_create_conversion_mercator_variant_a(c.cPJContext,
+		C.double(centerLat), C.double(centerLon), C.double(scale), C.double(falseEasting), C.double(falseNorthing),
+		nil, 0, nil, 0,
+	))
+}
+
+// NewMercatorVariantBConversion returns a new Mercator (variant B) conversion.
+// Angles are in degrees and distances in meters.
+func (c *Context) NewMercatorVariantBConversion(latFirstParallel, centerLon, falseEasting, falseNorthing float64) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+	return c.newPJ(C.proj_create_conversion_mercator_variant_b(c.cPJContext,
+		C.double(latFirstParallel), C.double(centerLon), C.double(falseEasting), C.double(falseNorthing),
+		nil, 0, nil, 0,
+	))
+}
+
+// NewObliqueStereographicConversion returns a new Oblique Stereographic
+// conversion. Angles are in degrees and distances in meters.
+func (c *Context) NewObliqueStereographicConversion(centerLat, centerLon, scale, falseEasting, falseNorthing float64) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+	return c.newPJ(C.proj_create_conversion_oblique_stereographic(c.cPJContext,
+		C.double(centerLat), C.double(centerLon), C.double(scale), C.double(falseEasting), C.double(falseNorthing),
+		nil, 0, nil, 0,
+	))
+}
+
+// NewOrthographicConversion returns a new Orthographic conversion. Angles are
+// in degrees and distances in meters.
+func (c *Context) NewOrthographicConversion(centerLat, centerLon, falseEasting, falseNorthing float64) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+	return c.newPJ(C.proj_create_conversion_orthographic(c.cPJContext,
+		C.double(centerLat), C.double(centerLon), C.double(falseEasting), C.double(falseNorthing),
+		nil, 0, nil, 0,
+	))
+}
+
+// NewPolarStereographicVariantAConversion returns a new Polar Stereographic
+// (variant A) conversion. Angles are in degrees and distances in meters.
+func (c *Context) NewPolarStereographicVariantAConversion(centerLat, centerLon, scale, falseEasting, falseNorthing float64) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+	return c.newPJ(C.proj_create_conversion_polar_stereographic_variant_a(c.cPJContext,
+		C.double(centerLat), C.double(centerLon), C.double(scale), C.double(falseEasting), C.double(falseNorthing),
+		nil, 0, nil, 0,
+	))
+}
+
+// NewPolarStereographicVariantBConversion returns a new Polar Stereographic
+// (variant B) conversion. Angles are in degrees and distances in meters.
+func (c *Context) NewPolarStereographicVariantBConversion(latStandardParallel, lonOrigin, falseEasting, falseNorthing float64) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+	return c.newPJ(C.proj_create_conversion_polar_stereographic_variant_b(c.cPJContext,
+		C.double(latStandardParallel), C.double(lonOrigin), C.double(falseEasting), C.double(falseNorthing),
+		nil, 0, nil, 0,
+	))
+}
+
+// NewProjectedCRS returns a new projected CRS from geodeticCRS and
+// conversion. If cs is nil then an easting, northing coordinate system in
+// meters is used.
+func (c *Context) NewProjectedCRS(name string, geodeticCRS, conversion, cs *PJ) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+	defer c.lockPJContexts(geodeticCRS, conversion, cs)()
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	var cCS *C.PJ
+	if cs != nil {
+		cCS = cs.cPJ
+	} else {
+		cCS = C.proj_create_cartesian_2D_cs(c.cPJContext, C.PJ_CART2D_EASTING_NORTHING, nil, 0)
+		if cCS == nil {
+			return nil, c.lastError()
+		}
+		defer C.proj_destroy(cCS)
+	}
+
+	return c.newPJ(C.proj_create_projected_crs(c.cPJContext, cName, geodeticCRS.cPJ, conversion.cPJ, cCS))
+}
+
+// NewStereographicConversion returns a new Stereographic conversion. Angles
+// are in degrees and distances in meters.
+func (c *Context) NewStereographicConversion(centerLat, centerLon, scale, falseEasting, falseNorthing float64) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+	return c.newPJ(C.proj_create_conversion_stereographic(c.cPJContext,
+		C.double(centerLat), C.double(centerLon), C.double(scale), C.double(falseEasting), C.double(falseNorthing),
+		nil, 0, nil, 0,
+	))
+}
+
+// NewTransverseMercatorConversion returns a new Transverse Mercator
+// conversion. Angles are in degrees and distances in meters.
+func (c *Context) NewTransverseMercatorConversion(centerLat, centerLon, scale, falseEasting, falseNorthing float64) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+	return c.newPJ(C.proj_create_conversion_transverse_mercator(c.cPJContext,
+		C.double(centerLat), C.double(centerLon), C.double(scale), C.double(falseEasting), C.double(falseNorthing),
+		nil, 0, nil, 0,
+	))
+}
+
+// NewUTMConversion returns a new UTM conversion for zone in the northern
+// hemisphere if north is true, or the southern hemisphere otherwise.
+func (c *Context) NewUTMConversion(zone int, north bool) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+	var cNorth C.int
+	if north {
+		cNorth = 1
+	}
+	return c.newPJ(C.proj_create_conversion_utm(c.cPJContext, C.int(zone), cNorth))
+}
+
+// NewVerticalCRS returns a new vertical CRS in meters.
+func (c *Context) NewVerticalCRS(name, datumName string) (*PJ, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	cDatumName := C.CString(datumName)
+	defer C.free(unsafe.Pointer(cDatumName))
+
+	return c.newPJ(C.proj_create_vertical_crs(c.cPJContext, cName, cDatumName, nil, 0))
+}
+
+// NewAlbersEqualAreaConversion returns a new Albers Equal Area conversion.
+// Angles are in degrees and distances in meters.
+func NewAlbersEqualAreaConversion(latFalseOrigin, lonFalseOrigin, latFirstParallel, latSecondParallel, eastingFalseOrigin, northingFalseOrigin float64) (*PJ, error) {
+	return defaultContext.NewAlbersEqualAreaConversion(latFalseOrigin, lonFalseOrigin, latFirstParallel, latSecondParallel, eastingFalseOrigin, northingFalseOrigin)
+}
+
+// NewAzimuthalEquidistantConversion returns a new Azimuthal Equidistant
+// conversion. Angles are in degrees and distances in meters.
+func NewAzimuthalEquidistantConversion(latNatOrigin, lonNatOrigin, falseEasting, falseNorthing float64) (*PJ, error) {
+	return defaultContext.NewAzimuthalEquidistantConversion(latNatOrigin, lonNatOrigin, falseEasting, falseNorthing)
+}
+
+// NewCS returns a new coordinate system of type csType with axes.
+func NewCS(csType CoordinateSystemType, axes []AxisDescription) (*PJ, error) {
+	return defaultContext.NewCS(csType, axes)
+}
+
+// NewCartesianCS2D returns a new 2D Cartesian coordinate system in meters.
+func NewCartesianCS2D(csType CartesianCS2DType) (*PJ, error) {
+	return defaultContext.NewCartesianCS2D(csType)
+}
+
+// NewCompoundCRS returns a new compound CRS from horizontalCRS and verticalCRS.
+func NewCompoundCRS(name string, horizontalCRS, verticalCRS *PJ) (*PJ, error) {
+	return defaultContext.NewCompoundCRS(name, horizontalCRS, verticalCRS)
+}
+
+// NewConversion returns a new conversion using method with params.
+func NewConversion(name string, method MethodInfo, params []ParamDescription) (*PJ, error) {
+	return defaultContext.NewConversion(name, method, params)
+}
+
+// NewEllipsoidalCS2D returns a new 2D ellipsoidal coordinate system in degrees.
+func NewEllipsoidalCS2D(csType EllipsoidalCS2DType) (*PJ, error) {
+	return defaultContext.NewEllipsoidalCS2D(csType)
+}
+
+// NewEngineeringCRS returns a new engineering CRS.
+func NewEngineeringCRS(name string) (*PJ, error) {
+	return defaultContext.NewEngineeringCRS(name)
+}
+
+// NewEquidistantCylindricalConversion returns a new Equidistant Cylindrical
+// conversion. Angles are in degrees and distances in meters.
+func NewEquidistantCylindricalConversion(latFirstParallel, lonNatOrigin, falseEasting, falseNorthing float64) (*PJ, error) {
+	return defaultContext.NewEquidistantCylindricalConversion(latFirstParallel, lonNatOrigin, falseEasting, falseNorthing)
+}
+
+// NewGeographicCRS returns a new geographic CRS with parameters.
+func NewGeographicCRS(parameters GeographicCRSParameters) (*PJ, error) {
+	return defaultContext.NewGeographicCRS(parameters)
+}
+
+// NewLambertAzimuthalEqualAreaConversion returns a new Lambert Azimuthal Equal
+// Area conversion. Angles are in degrees and distances in meters.
+func NewLambertAzimuthalEqualAreaConversion(latNatOrigin, lonNatOrigin, falseEasting, falseNorthing float64) (*PJ, error) {
+	return defaultContext.NewLambertAzimuthalEqualAreaConversion(latNatOrigin, lonNatOrigin, falseEasting, falseNorthing)
+}
+
+// NewLambertConicConformal1SPConversion returns a new Lambert Conic Conformal
+// (1SP) conversion. Angles are in degrees and distances in meters.
+func NewLambertConicConformal1SPConversion(centerLat, centerLon, scale, falseEasting, falseNorthing float64) (*PJ, error) {
+	return defaultContext.NewLambertConicConformal1SPConversion(centerLat, centerLon, scale, falseEasting, falseNorthing)
+}
+
+// NewLambertConicConformal2SPConversion returns a new Lambert Conic Conformal
+// (2SP) conversion. Angles are in degrees and distances in meters.
+func NewLambertConicConformal2SPConversion(latFalseOrigin, lonFalseOrigin, latFirstParallel, latSecondParallel, eastingFalseOrigin, northingFalseOrigin float64) (*PJ, error) {
+	return defaultContext.NewLambertConicConformal2SPConversion(latFalseOrigin, lonFalseOrigin, latFirstParallel, latSecondParallel, eastingFalseOrigin, northingFalseOrigin)
+}
+
+// NewMercatorVariantAConversion returns a new Mercator (variant A) conversion.
+// Angles are in degrees and distances in meters.
+func NewMercatorVariantAConversion(centerLat, centerLon, scale, falseEasting, falseNorthing float64) (*PJ, error) {
+	return defaultContext.NewMercatorVariantAConversion(centerLat, centerLon, scale, falseEasting, falseNorthing)
+}
+
+// NewMercatorVariantBConversion returns a new Mercator (variant B) conversion.
+// Angles are in degrees and distances in meters.
+func NewMercatorVariantBConversion(latFirstParallel, centerLon, falseEasting, falseNorthing float64) (*PJ, error) {
+	return defaultContext.NewMercatorVariantBConversion(latFirstParallel, centerLon, falseEasting, falseNorthing)
+}
+
+// NewObliqueStereographicConversion returns a new Oblique Stereographic
+// conversion. Angles are in degrees and distances in meters.
+func NewObliqueStereographicConversion(centerLat, centerLon, scale, falseEasting, falseNorthing float64) (*PJ, error) {
+	return defaultContext.NewObliqueStereographicConversion(centerLat, centerLon, scale, falseEasting, falseNorthing)
+}
+
+// NewOrthographicConversion returns a new Orthographic conversion. Angles are
+// in degrees and distances in meters.
+func NewOrthographicConversion(centerLat, centerLon, falseEasting, falseNorthing float64) (*PJ, error) {
+	return defaultContext.NewOrthographicConversion(centerLat, centerLon, falseEasting, falseNorthing)
+}
+
+// NewPolarStereographicVariantAConversion returns a new Polar Stereographic
+// (variant A) conversion. Angles are in degrees and distances in meters.
+func NewPolarStereographicVariantAConversion(centerLat, centerLon, scale, falseEasting, falseNorthing float64) (*PJ, error) {
+	return defaultContext.NewPolarStereographicVariantAConversion(centerLat, centerLon, scale, falseEasting, falseNorthing)
+}
+
+// NewPolarStereographicVariantBConversion returns a new Polar Stereographic
+// (variant B) conversion. Angles are in degrees and distances in meters.
+func NewPolarStereographicVariantBConversion(latStandardParallel, lonOrigin, falseEasting, falseNorthing float64) (*PJ, error) {
+	return defaultContext.NewPolarStereographicVariantBConversion(latStandardParallel, lonOrigin, falseEasting, falseNorthing)
+}
+
+// NewProjectedCRS returns a new projected CRS from geodeticCRS and
+// conversion. If cs is nil then an easting, northing coordinate system in
+// meters is used.
+func NewProjectedCRS(name string, geodeticCRS, conversion, cs *PJ) (*PJ, error) {
+	return defaultContext.NewProjectedCRS(name, geodeticCRS, conversion, cs)
+}
+
+// NewStereographicConversion returns a new Stereographic conversion. Angles
+// are in degrees and distances in meters.
+func NewStereographicConversion(centerLat, centerLon, scale, falseEasting, falseNorthing float64) (*PJ, error) {
+	return defaultContext.NewStereographicConversion(centerLat, centerLon, scale, falseEasting, falseNorthing)
+}
+
+// NewTransverseMercatorConversion returns a new Transverse Mercator
+// conversion. Angles are in degrees and distances in meters.
+func NewTransverseMercatorConversion(centerLat, centerLon, scale, falseEasting, falseNorthing float64) (*PJ, error) {
+	return defaultContext.NewTransverseMercatorConversion(centerLat, centerLon, scale, falseEasting, falseNorthing)
+}
+
+// NewUTMConversion returns a new UTM conversion for zone in the northern
+// hemisphere if north is true, or the southern hemisphere otherwise.
+func NewUTMConversion(zone int, north bool) (*PJ, error) {
+	return defaultContext.NewUTMConversion(zone, north)
+}
+
+// NewVerticalCRS returns a new vertical CRS in meters.
+func NewVerticalCRS(name, datumName string) (*PJ, error) {
+	return defaultContext.NewVerticalCRS(name, datumName)
+}
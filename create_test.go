@@ -0,0 +1,180 @@
+package proj_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-proj/v11"
+)
+
+func TestContext_NewProjectedCRS(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	pj, err := context.NewCRSToCRS("EPSG:4326", "EPSG:32632", nil)
+	assert.NoError(t, err)
+	expectedCoord, err := pj.Forward(zurichEPSG4326)
+	assert.NoError(t, err)
+
+	geographicCRS, err := context.NewGeographicCRS(proj.GeographicCRSParameters{
+		Name:           "WGS 84",
+		DatumName:      "World Geodetic System 1984",
+		EllipsoidName:  "WGS 84",
+		SemiMajorMeter: 6378137,
+		InvFlattening:  298.257223563,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, proj.PJTypeGeographic2DCRS, geographicCRS.Type())
+	assertAxisOrder(t, proj.AxisOrderLatLon, geographicCRS)
+
+	utmConversion, err := context.NewUTMConversion(32, true)
+	assert.NoError(t, err)
+
+	transverseMercatorConversion, err := context.NewTransverseMercatorConversion(0, 9, 0.9996, 500000, 0)
+	assert.NoError(t, err)
+
+	genericConversion, err := context.NewConversion("Transverse Mercator", proj.MethodInfo{
+		Name:     "Transverse Mercator",
+		AuthName: "EPSG",
+		Code:     "9807",
+	}, []proj.ParamDescription{
+		{Name: "Latitude of natural origin", AuthName: "EPSG", Code: "8801", Value: 0, UnitName: "degree", UnitConvFactor: 0.0174532925199433, UnitType: proj.UnitTypeAngular},
+		{Name: "Longitude of natural origin", AuthName: "EPSG", Code: "8802", Value: 9, UnitName: "degree", UnitConvFactor: 0.0174532925199433, UnitType: proj.UnitTypeAngular},
+		{Name: "Scale factor at natural origin", AuthName: "EPSG", Code: "8805", Value: 0.9996, UnitName: "unity", UnitConvFactor: 1, UnitType: proj.UnitTypeScale},
+		{Name: "False easting", AuthName: "EPSG", Code: "8806", Value: 500000, UnitName: "metre", UnitConvFactor: 1, UnitType: proj.UnitTypeLinear},
+		{Name: "False northing", AuthName: "EPSG", Code: "8807", Value: 0, UnitName: "metre", UnitConvFactor: 1, UnitType: proj.UnitTypeLinear},
+	})
+	assert.NoError(t, err)
+
+	for _, tc := range []struct {
+		name       string
+		conversion *proj.PJ
+	}{
+		{
+			name:       "utm",
+			conversion: utmConversion,
+		},
+		{
+			name:       "transverse_mercator",
+			conversion: transverseMercatorConversion,
+		},
+		{
+			name:       "generic",
+			conversion: genericConversion,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			methodInfos, err := tc.conversion.MethodInfo()
+			assert.NoError(t, err)
+			assert.Equal(t, 1, len(methodInfos))
+			assert.Equal(t, "9807", methodInfos[0].Code)
+
+			projectedCRS, err := context.NewProjectedCRS("Custom UTM zone 32N", geographicCRS, tc.conversion, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, proj.PJTypeProjectedCRS, projectedCRS.Type())
+			assert.Equal(t, "Custom UTM zone 32N", projectedCRS.Name())
+			assertAxisOrder(t, proj.AxisOrderLonLat, projectedCRS)
+
+			pj, err := context.NewCRSToCRSFromPJ(geographicCRS, projectedCRS, nil, "")
+			assert.NoError(t, err)
+			actualCoord, err := pj.Forward(zurichEPSG4326)
+			assert.NoError(t, err)
+			assertInDeltaFloat64Slice(t, expectedCoord[:], actualCoord[:], 1e-3)
+		})
+	}
+}
+
+func TestContext_NewCS(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	geographicCS, err := context.NewEllipsoidalCS2D(proj.EllipsoidalCS2DTypeLongitudeLatitude)
+	assert.NoError(t, err)
+
+	geographicCRS, err := context.NewGeographicCRS(proj.GeographicCRSParameters{
+		Name:                "NTF (Paris)",
+		DatumName:           "Nouvelle Triangulation Francaise (Paris)",
+		EllipsoidName:       "Clarke 1880 (IGN)",
+		SemiMajorMeter:      6378249.2,
+		InvFlattening:       293.466021293627,
+		PrimeMeridianName:   "Paris",
+		PrimeMeridianOffset: 2.33722917,
+		CS:                  geographicCS,
+	})
+	assert.NoError(t, err)
+	assertAxisOrder(t, proj.AxisOrderLonLat, geographicCRS)
+
+	primeMeridian, err := geographicCRS.PrimeMeridian()
+	assert.NoError(t, err)
+	assert.Equal(t, "Paris", primeMeridian.Name())
+
+	cs, err := context.NewCS(proj.CoordinateSystemTypeCartesian, []proj.AxisDescription{
+		{Name: "Northing", Abbrev: "N", Direction: "north", UnitName: "metre", UnitConvFactor: 1, UnitType: proj.UnitTypeLinear},
+		{Name: "Easting", Abbrev: "E", Direction: "east", UnitName: "metre", UnitConvFactor: 1, UnitType: proj.UnitTypeLinear},
+	})
+	assert.NoError(t, err)
+
+	conversion, err := context.NewLambertConicConformal1SPConversion(46.8, 0, 0.99987742, 600000, 2200000)
+	assert.NoError(t, err)
+
+	projectedCRS, err := context.NewProjectedCRS("NTF (Paris) / Lambert zone II", geographicCRS, conversion, cs)
+	assert.NoError(t, err)
+	assertAxisOrder(t, proj.AxisOrderLatLon, projectedCRS)
+
+	coordinateSystem, err := projectedCRS.CoordinateSystem()
+	assert.NoError(t, err)
+	assert.Equal(t, proj.CoordinateSystemTypeCartesian, coordinateSystem.Type)
+	assert.Equal(t, "Northing", coordinateSystem.Axes[0].Name)
+	assert.Equal(t, "Easting", coordinateSystem.Axes[1].Name)
+}
+
+func TestContext_NewCompoundCRS(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	otherContext := proj.NewContext()
+	assert.NotZero(t, otherContext)
+
+	horizontalCRS, err := otherContext.New("EPSG:2056")
+	assert.NoError(t, err)
+
+	verticalCRS, err := context.NewVerticalCRS("Local height", "Local vertical datum")
+	assert.NoError(t, err)
+	assert.Equal(t, proj.PJTypeVerticalCRS, verticalCRS.Type())
+
+	compoundCRS, err := context.NewCompoundCRS("CH1903+ / LV95 + Local height", horizontalCRS, verticalCRS)
+	assert.NoError(t, err)
+	assert.Equal(t, proj.PJTypeCompoundCRS, compoundCRS.Type())
+	assert.Equal(t, "CH1903+ / LV95 + Local height", compoundCRS.Name())
+
+	subCRS, err := compoundCRS.SubCRS(1)
+	assert.NoError(t, err)
+	assert.Equal(t, "Local height", subCRS.Name())
+}
+
+func TestContext_NewEngineeringCRS(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	engineeringCRS, err := context.NewEngineeringCRS("Site grid")
+	assert.NoError(t, err)
+	assert.Equal(t, proj.PJTypeEngineeringCRS, engineeringCRS.Type())
+	assert.Equal(t, "Site grid", engineeringCRS.Name())
+}
+
+func assertAxisOrder(tb testing.TB, expected proj.AxisOrder, crs *proj.PJ) {
+	tb.Helper()
+	actual, err := crs.AxisOrder()
+	assert.NoError(tb, err)
+	assert.Equal(tb, expected, actual)
+}
@@ -0,0 +1,455 @@
+package proj
+
+// #include <stdlib.h>
+// #include "go-proj.h"
+// #cgo nocallback proj_coordoperation_get_towgs84_values
+// #cgo nocallback proj_create
+// #cgo nocallback proj_crs_create_bound_crs_to_WGS84
+// #cgo nocallback proj_crs_create_bound_vertical_crs
+// #cgo nocallback proj_crs_create_projected_3D_crs_from_2D
+// #cgo nocallback proj_crs_demote_to_2D
+// #cgo nocallback proj_crs_get_coordoperation
+// #cgo nocallback proj_crs_get_datum
+// #cgo nocallback proj_crs_get_datum_ensemble
+// #cgo nocallback proj_crs_get_datum_forced
+// #cgo nocallback proj_crs_get_geodetic_crs
+// #cgo nocallback proj_crs_get_sub_crs
+// #cgo nocallback proj_crs_is_derived
+// #cgo nocallback proj_crs_promote_to_3D
+// #cgo nocallback proj_datum_ensemble_get_accuracy
+// #cgo nocallback proj_datum_ensemble_get_member
+// #cgo nocallback proj_datum_ensemble_get_member_count
+// #cgo nocallback proj_destroy
+// #cgo nocallback proj_ellipsoid_get_parameters
+// #cgo nocallback proj_errno_reset
+// #cgo nocallback proj_errno_restore
+// #cgo nocallback proj_get_ellipsoid
+// #cgo nocallback proj_get_name
+// #cgo nocallback proj_get_prime_meridian
+// #cgo nocallback proj_get_source_crs
+// #cgo nocallback proj_get_target_crs
+// #cgo nocallback proj_get_type
+// #cgo nocallback proj_is_equivalent_to_with_ctx
+// #cgo nocallback proj_prime_meridian_get_parameters
+// #cgo noescape proj_coordoperation_get_towgs84_values
+// #cgo noescape proj_create
+// #cgo noescape proj_crs_create_bound_crs_to_WGS84
+// #cgo noescape proj_crs_create_bound_vertical_crs
+// #cgo noescape proj_crs_create_projected_3D_crs_from_2D
+// #cgo noescape proj_crs_demote_to_2D
+// #cgo noescape proj_crs_get_coordoperation
+// #cgo noescape proj_crs_get_datum
+// #cgo noescape proj_crs_get_datum_ensemble
+// #cgo noescape proj_crs_get_datum_forced
+// #cgo noescape proj_crs_get_geodetic_crs
+// #cgo noescape proj_crs_get_sub_crs
+// #cgo noescape proj_crs_is_derived
+// #cgo noescape proj_crs_promote_to_3D
+// #cgo noescape proj_datum_ensemble_get_accuracy
+// #cgo noescape proj_datum_ensemble_get_member
+// #cgo noescape proj_datum_ensemble_get_member_count
+// #cgo noescape proj_destroy
+// #cgo noescape proj_ellipsoid_get_parameters
+// #cgo noescape proj_errno_reset
+// #cgo noescape proj_errno_restore
+// #cgo noescape proj_get_ellipsoid
+// #cgo noescape proj_get_name
+// #cgo noescape proj_get_prime_meridian
+// #cgo noescape proj_get_source_crs
+// #cgo noescape proj_get_target_crs
+// #cgo noescape proj_get_type
+// #cgo noescape proj_is_equivalent_to_with_ctx
+// #cgo noescape proj_prime_meridian_get_parameters
+import "C"
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// EllipsoidParameters contains the parameters of an ellipsoid.
+type EllipsoidParameters struct {
+	SemiMajorMeter      float64
+	SemiMinorMeter      float64
+	IsSemiMinorComputed bool
+	InvFlattening       float64
+}
+
+// PrimeMeridianParameters contains the parameters of a prime meridian.
+type PrimeMeridianParameters struct {
+	Longitude      float64
+	UnitConvFactor float64
+	UnitName       string
+}
+
+// BoundToWGS84 returns a new bound CRS from pj, which must be a CRS, to WGS 84
+// using a Helmert transformation, as used by WKT1 TOWGS84 clauses. options are
+// PROJ options, for example "ALLOW_INTERMEDIATE_CRS=NEVER". If pj is already
+// a bound CRS to WGS 84 then it is returned unchanged. If pj is a compound CRS
+// then the result is a compound CRS whose horizontal CRS is bound. It returns
+// ErrAlreadyWGS84 if pj is based on WGS 84, and so needs no transformation, and
+// ErrNoHelmertTransformation if there is no such transformation.
+func (pj *PJ) BoundToWGS84(options ...string) (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	cOptions := make([]*C.char, len(options)+1)
+	for i, option := range options {
+		cOptions[i] = C.CString(option)
+		defer C.free(unsafe.Pointer(cOptions[i]))
+	}
+
+	boundCRS, err := pj.context.newPJ(C.proj_crs_create_bound_crs_to_WGS84(pj.context.cPJContext, pj.cPJ, &cOptions[0]))
+	if err != nil {
+		return nil, err
+	}
+	defer runtime.KeepAlive(boundCRS)
+	if isBoundCRS(boundCRS.cPJ) {
+		return boundCRS, nil
+	}
+	if C.proj_get_type(boundCRS.cPJ) == C.PJ_TYPE_COMPOUND_CRS {
+		cHorizontalCRS := C.proj_crs_get_sub_crs(pj.context.cPJContext, boundCRS.cPJ, 0)
+		if cHorizontalCRS != nil {
+			defer C.proj_destroy(cHorizontalCRS)
+			if isBoundCRS(cHorizontalCRS) {
+				return boundCRS, nil
+			}
+		}
+	}
+	if pj.isWGS84() {
+		return nil, ErrAlreadyWGS84
+	}
+	return nil, ErrNoHelmertTransformation
+}
+
+// BoundVertical returns a new bound CRS from pj, which must be a vertical CRS,
+// to hubCRS, which must be a geographic 3D CRS, using the geoid grid gridName.
+func (pj *PJ) BoundVertical(hubCRS *PJ, gridName string) (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	defer pj.context.lockPJContexts(hubCRS)()
+
+	cGridName := C.CString(gridName)
+	defer C.free(unsafe.Pointer(cGridName))
+
+	return pj.context.newPJ(C.proj_crs_create_bound_vertical_crs(pj.context.cPJContext, pj.cPJ, hubCRS.cPJ, cGridName))
+}
+
+// CoordOperation returns the coordinate operation of pj, which must be a
+// derived CRS or a bound CRS. For a derived CRS, such as a projected CRS, this
+// is the conversion from its base CRS. For a bound CRS this is the
+// transformation to its hub CRS.
+func (pj *PJ) CoordOperation() (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	return pj.context.newPJ(C.proj_crs_get_coordoperation(pj.context.cPJContext, pj.cPJ))
+}
+
+// Datum returns pj's datum. It returns ErrNotFound if pj has a datum ensemble
+// instead of a datum. See also DatumEnsemble and DatumForced.
+func (pj *PJ) Datum() (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	return pj.context.newPJ(C.proj_crs_get_datum(pj.context.cPJContext, pj.cPJ))
+}
+
+// DatumEnsemble returns pj's datum ensemble. It returns ErrNotFound if pj has
+// a datum instead of a datum ensemble.
+func (pj *PJ) DatumEnsemble() (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	return pj.context.newPJ(C.proj_crs_get_datum_ensemble(pj.context.cPJContext, pj.cPJ))
+}
+
+// DatumEnsembleAccuracy returns the positional accuracy of pj, which must be a
+// datum ensemble, in meters.
+func (pj *PJ) DatumEnsembleAccuracy() (float64, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	accuracy := float64(C.proj_datum_ensemble_get_accuracy(pj.context.cPJContext, pj.cPJ))
+	if accuracy < 0 {
+		return 0, pj.context.lastError()
+	}
+	return accuracy, nil
+}
+
+// DatumEnsembleMembers returns the members of pj, which must be a datum
+// ensemble.
+func (pj *PJ) DatumEnsembleMembers() ([]*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	n := int(C.proj_datum_ensemble_get_member_count(pj.context.cPJContext, pj.cPJ))
+	if n <= 0 {
+		return nil, pj.context.lastError()
+	}
+	members := make([]*PJ, n)
+	for i := range members {
+		member, err := pj.context.newPJ(C.proj_datum_ensemble_get_member(pj.context.cPJContext, pj.cPJ, C.int(i)))
+		if err != nil {
+			return nil, err
+		}
+		members[i] = member
+	}
+	return members, nil
+}
+
+// DatumForced returns pj's datum. If pj has a datum ensemble then a datum
+// equivalent to the datum ensemble is returned.
+func (pj *PJ) DatumForced() (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	return pj.context.newPJ(C.proj_crs_get_datum_forced(pj.context.cPJContext, pj.cPJ))
+}
+
+// DemoteTo2D returns a new 2D CRS from pj, which must be a 3D CRS. If name is
+// empty then the name of pj is used.
+func (pj *PJ) DemoteTo2D(name string) (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	cName := cStringOrNil(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	return pj.context.newPJ(C.proj_crs_demote_to_2D(pj.context.cPJContext, cName, pj.cPJ))
+}
+
+// Ellipsoid returns the ellipsoid of pj, which must be a CRS, datum, or datum
+// ensemble.
+func (pj *PJ) Ellipsoid() (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	return pj.context.newPJ(C.proj_get_ellipsoid(pj.context.cPJContext, pj.cPJ))
+}
+
+// EllipsoidParameters returns the parameters of pj, which must be an
+// ellipsoid.
+func (pj *PJ) EllipsoidParameters() (EllipsoidParameters, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	var semiMajorMeter, semiMinorMeter, invFlattening C.double
+	var isSemiMinorComputed C.int
+	if C.proj_ellipsoid_get_parameters(pj.context.cPJContext, pj.cPJ, &semiMajorMeter, &semiMinorMeter, &isSemiMinorComputed, &invFlattening) == 0 {
+		return EllipsoidParameters{}, pj.context.lastError()
+	}
+	return EllipsoidParameters{
+		SemiMajorMeter:      float64(semiMajorMeter),
+		SemiMinorMeter:      float64(semiMinorMeter),
+		IsSemiMinorComputed: isSemiMinorComputed != 0,
+		InvFlattening:       float64(invFlattening),
+	}, nil
+}
+
+// GeodeticCRS returns the geodetic CRS of pj, which must be a CRS.
+func (pj *PJ) GeodeticCRS() (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	return pj.context.newPJ(C.proj_crs_get_geodetic_crs(pj.context.cPJContext, pj.cPJ))
+}
+
+// IsDerived returns whether pj is a derived CRS.
+func (pj *PJ) IsDerived() bool {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+	return C.proj_crs_is_derived(pj.context.cPJContext, pj.cPJ) != 0
+}
+
+// Name returns pj's name.
+func (pj *PJ) Name() string {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+	return C.GoString(C.proj_get_name(pj.cPJ))
+}
+
+// PrimeMeridian returns the prime meridian of pj, which must be a CRS or a
+// datum.
+func (pj *PJ) PrimeMeridian() (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	return pj.context.newPJ(C.proj_get_prime_meridian(pj.context.cPJContext, pj.cPJ))
+}
+
+// PrimeMeridianParameters returns the parameters of pj, which must be a prime
+// meridian.
+func (pj *PJ) PrimeMeridianParameters() (PrimeMeridianParameters, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	var longitude, unitConvFactor C.double
+	var unitName *C.char
+	if C.proj_prime_meridian_get_parameters(pj.context.cPJContext, pj.cPJ, &longitude, &unitConvFactor, &unitName) == 0 {
+		return PrimeMeridianParameters{}, pj.context.lastError()
+	}
+	return PrimeMeridianParameters{
+		Longitude:      float64(longitude),
+		UnitConvFactor: float64(unitConvFactor),
+		UnitName:       C.GoString(unitName),
+	}, nil
+}
+
+// Projected3DFrom2D returns a new 3D projected CRS from pj, which must be a 2D
+// projected CRS, using geog3D as the base CRS. If geog3D is nil then the base
+// CRS of pj is promoted to 3D.
+func (pj *PJ) Projected3DFrom2D(geog3D *PJ) (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+	defer pj.context.lockPJContexts(geog3D)()
+
+	var cGeog3D *C.PJ
+	if geog3D != nil {
+		cGeog3D = geog3D.cPJ
+	}
+
+	return pj.context.newPJ(C.proj_crs_create_projected_3D_crs_from_2D(pj.context.cPJContext, nil, pj.cPJ, cGeog3D))
+}
+
+// PromoteTo3D returns a new 3D CRS from pj, which must be a 2D CRS, with an
+// ellipsoidal height axis. If name is empty then the name of pj is used.
+func (pj *PJ) PromoteTo3D(name string) (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	cName := cStringOrNil(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	return pj.context.newPJ(C.proj_crs_promote_to_3D(pj.context.cPJContext, cName, pj.cPJ))
+}
+
+// SourceCRS returns the source CRS of pj, which must be a coordinate
+// operation, a bound CRS, or a derived CRS. For a bound CRS this is its base
+// CRS, and for a derived CRS this is the CRS it is derived from.
+func (pj *PJ) SourceCRS() (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	return pj.context.newPJ(C.proj_get_source_crs(pj.context.cPJContext, pj.cPJ))
+}
+
+// SubCRS returns the sub CRS of pj, which must be a compound CRS, at index.
+// Typically, index 0 is the horizontal CRS and index 1 is the vertical CRS.
+func (pj *PJ) SubCRS(index int) (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	return pj.context.newPJ(C.proj_crs_get_sub_crs(pj.context.cPJContext, pj.cPJ, C.int(index)))
+}
+
+// TOWGS84 returns the seven TOWGS84 parameters of pj, which must be a
+// transformation that can be expressed as a Helmert transformation, for
+// example the coordinate operation of a bound CRS. The parameters are the
+// translations in meters, the rotations in arc-seconds using the position
+// vector convention, and the scale difference in parts per million.
+func (pj *PJ) TOWGS84() ([]float64, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	values := make([]float64, 7)
+	if C.proj_coordoperation_get_towgs84_values(pj.context.cPJContext, pj.cPJ, (*C.double)(&values[0]), C.int(len(values)), 1) == 0 {
+		return nil, pj.context.lastError()
+	}
+	return values, nil
+}
+
+// TargetCRS returns the target CRS of pj, which must be a coordinate
+// operation or a bound CRS. For a bound CRS this is its hub CRS.
+func (pj *PJ) TargetCRS() (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	return pj.context.newPJ(C.proj_get_target_crs(pj.context.cPJContext, pj.cPJ))
+}
+
+// isWGS84 returns whether the geodetic CRS of pj is WGS 84. pj's context must be
+// locked.
+func (pj *PJ) isWGS84() bool {
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	cGeodeticCRS := C.proj_crs_get_geodetic_crs(pj.context.cPJContext, pj.cPJ)
+	if cGeodeticCRS == nil {
+		return false
+	}
+	defer C.proj_destroy(cGeodeticCRS)
+
+	for _, wgs84CRS := range pj.context.wgs84CRSs() {
+		if C.proj_is_equivalent_to_with_ctx(pj.context.cPJContext, cGeodeticCRS, wgs84CRS.cPJ, C.PJ_COMP_EQUIVALENT_EXCEPT_AXIS_ORDER_GEOGCRS) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// isBoundCRS returns whether cPJ is a bound CRS.
+func isBoundCRS(cPJ *C.PJ) bool {
+	return C.proj_get_type(cPJ) == C.PJ_TYPE_BOUND_CRS
+}
@@ -0,0 +1,435 @@
+package proj_test
+
+import (
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-proj/v11"
+)
+
+func TestPJ_Ellipsoid(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	for _, tc := range []struct {
+		definition         string
+		expectedName       string
+		expectedParameters proj.EllipsoidParameters
+	}{
+		{
+			definition:   "EPSG:4326",
+			expectedName: "WGS 84",
+			expectedParameters: proj.EllipsoidParameters{
+				SemiMajorMeter:      6378137,
+				SemiMinorMeter:      6356752.314245179,
+				IsSemiMinorComputed: true,
+				InvFlattening:       298.257223563,
+			},
+		},
+		{
+			definition:   "EPSG:2056",
+			expectedName: "Bessel 1841",
+			expectedParameters: proj.EllipsoidParameters{
+				SemiMajorMeter:      6377397.155,
+				SemiMinorMeter:      6356078.962818189,
+				IsSemiMinorComputed: true,
+				InvFlattening:       299.1528128,
+			},
+		},
+	} {
+		t.Run(tc.definition, func(t *testing.T) {
+			crs, err := context.New(tc.definition)
+			assert.NoError(t, err)
+
+			ellipsoid, err := crs.Ellipsoid()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedName, ellipsoid.Name())
+
+			actualParameters, err := ellipsoid.EllipsoidParameters()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedParameters.SemiMajorMeter, actualParameters.SemiMajorMeter)
+			assertInDelta(t, tc.expectedParameters.SemiMinorMeter, actualParameters.SemiMinorMeter, 1e-6)
+			assert.Equal(t, tc.expectedParameters.IsSemiMinorComputed, actualParameters.IsSemiMinorComputed)
+			assert.Equal(t, tc.expectedParameters.InvFlattening, actualParameters.InvFlattening)
+		})
+	}
+}
+
+func TestPJ_GeodeticCRS(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	crs, err := context.New("EPSG:2056")
+	assert.NoError(t, err)
+
+	geodeticCRS, err := crs.GeodeticCRS()
+	assert.NoError(t, err)
+	assert.True(t, geodeticCRS.IsCRS())
+	assert.Equal(t, "CH1903+", geodeticCRS.Name())
+
+	datum, err := geodeticCRS.Datum()
+	assert.NoError(t, err)
+	assert.Equal(t, "CH1903+", datum.Name())
+
+	_, err = geodeticCRS.DatumEnsemble()
+	assert.IsError(t, err, proj.ErrNotFound)
+}
+
+func TestPJ_DatumEnsemble_previousError(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	crs, err := context.New("EPSG:2056")
+	assert.NoError(t, err)
+
+	geodeticCRS, err := crs.GeodeticCRS()
+	assert.NoError(t, err)
+
+	_, err = context.New("invalid")
+	var projError *proj.Error
+	assert.True(t, errors.As(err, &projError))
+
+	_, err = geodeticCRS.DatumEnsemble()
+	assert.IsError(t, err, proj.ErrNotFound)
+}
+
+func TestPJ_DatumEnsemble(t *testing.T) {
+	if proj.VersionMajor < 8 {
+		t.Skip("datum ensembles not tested")
+	}
+
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	crs, err := context.New("EPSG:4326")
+	assert.NoError(t, err)
+
+	_, err = crs.Datum()
+	assert.IsError(t, err, proj.ErrNotFound)
+
+	datum, err := crs.DatumForced()
+	assert.NoError(t, err)
+	assert.Equal(t, "World Geodetic System 1984", datum.Name())
+
+	datumEnsemble, err := crs.DatumEnsemble()
+	assert.NoError(t, err)
+	assert.Equal(t, "World Geodetic System 1984 ensemble", datumEnsemble.Name())
+
+	accuracy, err := datumEnsemble.DatumEnsembleAccuracy()
+	assert.NoError(t, err)
+	assert.Equal(t, 2., accuracy)
+
+	members, err := datumEnsemble.DatumEnsembleMembers()
+	assert.NoError(t, err)
+	assert.True(t, len(members) >= 6)
+	assert.Equal(t, "World Geodetic System 1984 (Transit)", members[0].Name())
+
+	ellipsoid, err := datumEnsemble.Ellipsoid()
+	assert.NoError(t, err)
+	assert.Equal(t, "WGS 84", ellipsoid.Name())
+}
+
+func TestPJ_PrimeMeridian(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	for _, tc := range []struct {
+		definition         string
+		expectedName       string
+		expectedParameters proj.PrimeMeridianParameters
+	}{
+		{
+			definition:   "EPSG:4326",
+			expectedName: "Greenwich",
+			expectedParameters: proj.PrimeMeridianParameters{
+				Longitude:      0,
+				UnitConvFactor: 0.0174532925199433,
+				UnitName:       "degree",
+			},
+		},
+		{
+			definition:   "EPSG:4807",
+			expectedName: "Paris",
+			expectedParameters: proj.PrimeMeridianParameters{
+				Longitude:      2.5969213,
+				UnitConvFactor: 0.015707963267949,
+				UnitName:       "grad",
+			},
+		},
+	} {
+		t.Run(tc.definition, func(t *testing.T) {
+			crs, err := context.New(tc.definition)
+			assert.NoError(t, err)
+
+			primeMeridian, err := crs.PrimeMeridian()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedName, primeMeridian.Name())
+
+			actualParameters, err := primeMeridian.PrimeMeridianParameters()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedParameters.Longitude, actualParameters.Longitude)
+			assertInDelta(t, tc.expectedParameters.UnitConvFactor, actualParameters.UnitConvFactor, 1e-15)
+			assert.Equal(t, tc.expectedParameters.UnitName, actualParameters.UnitName)
+		})
+	}
+}
+
+func TestPJ_SubCRS(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	crs, err := context.New("EPSG:7415")
+	assert.NoError(t, err)
+	assert.Equal(t, proj.PJTypeCompoundCRS, crs.Type())
+
+	horizontalCRS, err := crs.SubCRS(0)
+	assert.NoError(t, err)
+	assert.Equal(t, proj.PJTypeProjectedCRS, horizontalCRS.Type())
+	assert.Equal(t, "Amersfoort / RD New", horizontalCRS.Name())
+
+	verticalCRS, err := crs.SubCRS(1)
+	assert.NoError(t, err)
+	assert.Equal(t, proj.PJTypeVerticalCRS, verticalCRS.Type())
+	assert.Equal(t, "NAP height", verticalCRS.Name())
+
+	_, err = crs.SubCRS(2)
+	assert.Error(t, err)
+}
+
+func TestPJ_TOWGS84(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	crs, err := context.New("+proj=longlat +ellps=GRS80 +towgs84=1,2,3,4,5,6,7 +type=crs")
+	assert.NoError(t, err)
+	assert.Equal(t, proj.PJTypeBoundCRS, crs.Type())
+	assert.False(t, crs.IsDerived())
+
+	sourceCRS, err := crs.SourceCRS()
+	assert.NoError(t, err)
+	assert.Equal(t, proj.PJTypeGeographic2DCRS, sourceCRS.Type())
+
+	targetCRS, err := crs.TargetCRS()
+	assert.NoError(t, err)
+	assert.Equal(t, "WGS 84", targetCRS.Name())
+
+	transformation, err := crs.CoordOperation()
+	assert.NoError(t, err)
+	assert.Equal(t, proj.PJTypeTransformation, transformation.Type())
+
+	towgs84, err := transformation.TOWGS84()
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1, 2, 3, 4, 5, 6, 7}, towgs84)
+}
+
+func TestPJ_IsDerived(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	geographicCRS, err := context.New("EPSG:4326")
+	assert.NoError(t, err)
+	assert.False(t, geographicCRS.IsDerived())
+
+	_, err = geographicCRS.CoordOperation()
+	assert.Error(t, err)
+
+	projectedCRS, err := context.New("EPSG:2056")
+	assert.NoError(t, err)
+	assert.True(t, projectedCRS.IsDerived())
+
+	baseCRS, err := projectedCRS.SourceCRS()
+	assert.NoError(t, err)
+	assert.Equal(t, "CH1903+", baseCRS.Name())
+
+	conversion, err := projectedCRS.CoordOperation()
+	assert.NoError(t, err)
+	assert.Equal(t, proj.PJTypeConversion, conversion.Type())
+
+	_, err = conversion.TOWGS84()
+	assert.Error(t, err)
+}
+
+func TestPJ_SourceCRS_TargetCRS(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	pj, err := context.NewCRSToCRS("EPSG:4326", "EPSG:3857", nil)
+	assert.NoError(t, err)
+
+	sourceCRS, err := pj.SourceCRS()
+	assert.NoError(t, err)
+	assert.Equal(t, "WGS 84", sourceCRS.Name())
+
+	targetCRS, err := pj.TargetCRS()
+	assert.NoError(t, err)
+	assert.Equal(t, "WGS 84 / Pseudo-Mercator", targetCRS.Name())
+}
+
+func TestPJ_PromoteTo3D_DemoteTo2D(t *testing.T) {
+	if proj.VersionMajor < 7 {
+		t.Skip("promotion and demotion not supported")
+	}
+
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	crs2D, err := context.New("EPSG:4326")
+	assert.NoError(t, err)
+
+	crs3D, err := crs2D.PromoteTo3D("")
+	assert.NoError(t, err)
+	assert.Equal(t, proj.PJTypeGeographic3DCRS, crs3D.Type())
+	assert.Equal(t, "WGS 84", crs3D.Name())
+
+	coordinateSystem, err := crs3D.CoordinateSystem()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(coordinateSystem.Axes))
+
+	demotedCRS, err := crs3D.DemoteTo2D("WGS 84 (2D)")
+	assert.NoError(t, err)
+	assert.Equal(t, proj.PJTypeGeographic2DCRS, demotedCRS.Type())
+	assert.Equal(t, "WGS 84 (2D)", demotedCRS.Name())
+}
+
+func TestPJ_Projected3DFrom2D(t *testing.T) {
+	if proj.VersionMajor < 7 {
+		t.Skip("3D projected CRS creation not tested")
+	}
+
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	projectedCRS, err := context.New("EPSG:32632")
+	assert.NoError(t, err)
+
+	otherContext := proj.NewContext()
+	assert.NotZero(t, otherContext)
+
+	geog3DCRS, err := otherContext.New("EPSG:4979")
+	assert.NoError(t, err)
+
+	for _, geog3D := range []*proj.PJ{nil, geog3DCRS} {
+		projected3DCRS, err := projectedCRS.Projected3DFrom2D(geog3D)
+		assert.NoError(t, err)
+		assert.Equal(t, proj.PJTypeProjectedCRS, projected3DCRS.Type())
+		assert.Equal(t, "WGS 84 / UTM zone 32N", projected3DCRS.Name())
+
+		coordinateSystem, err := projected3DCRS.CoordinateSystem()
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(coordinateSystem.Axes))
+
+		baseCRS, err := projected3DCRS.SourceCRS()
+		assert.NoError(t, err)
+		assert.Equal(t, proj.PJTypeGeographic3DCRS, baseCRS.Type())
+	}
+}
+
+func TestPJ_BoundToWGS84(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	crs, err := context.New("EPSG:2056")
+	assert.NoError(t, err)
+
+	boundCRS, err := crs.BoundToWGS84()
+	assert.NoError(t, err)
+	assert.Equal(t, proj.PJTypeBoundCRS, boundCRS.Type())
+
+	transformation, err := boundCRS.CoordOperation()
+	assert.NoError(t, err)
+
+	towgs84, err := transformation.TOWGS84()
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{674.374, 15.056, 405.346, 0, 0, 0, 0}, towgs84)
+
+	wkt, err := boundCRS.AsWKT(proj.WKTType1GDAL, "MULTILINE=NO")
+	assert.NoError(t, err)
+	assert.True(t, strings.Contains(wkt, "TOWGS84[674.374,15.056,405.346,0,0,0,0]"))
+
+	engineeringCRS, err := context.NewEngineeringCRS("Site grid")
+	assert.NoError(t, err)
+
+	_, err = engineeringCRS.BoundToWGS84()
+	assert.IsError(t, err, proj.ErrNoHelmertTransformation)
+
+	reboundCRS, err := boundCRS.BoundToWGS84()
+	assert.NoError(t, err)
+	assert.Equal(t, proj.PJTypeBoundCRS, reboundCRS.Type())
+	assert.True(t, reboundCRS.IsEquivalentTo(boundCRS, proj.ComparisonEquivalent))
+
+	compoundCRS, err := context.New("EPSG:7415")
+	assert.NoError(t, err)
+
+	boundCompoundCRS, err := compoundCRS.BoundToWGS84()
+	assert.NoError(t, err)
+	assert.Equal(t, proj.PJTypeCompoundCRS, boundCompoundCRS.Type())
+
+	horizontalCRS, err := boundCompoundCRS.SubCRS(0)
+	assert.NoError(t, err)
+	assert.Equal(t, proj.PJTypeBoundCRS, horizontalCRS.Type())
+
+	for _, definition := range []string{"EPSG:4326", "EPSG:4979", "EPSG:32632"} {
+		wgs84CRS, err := context.New(definition)
+		assert.NoError(t, err)
+
+		_, err = wgs84CRS.BoundToWGS84()
+		assert.IsError(t, err, proj.ErrAlreadyWGS84)
+	}
+}
+
+func TestPJ_BoundVertical(t *testing.T) {
+	if proj.VersionMajor < 7 {
+		t.Skip("bound vertical CRS creation not tested")
+	}
+
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	verticalCRS, err := context.New("EPSG:5703")
+	assert.NoError(t, err)
+
+	hubCRS, err := context.New("EPSG:4979")
+	assert.NoError(t, err)
+
+	boundCRS, err := verticalCRS.BoundVertical(hubCRS, "us_noaa_g2018u0.tif")
+	assert.NoError(t, err)
+	assert.Equal(t, proj.PJTypeBoundCRS, boundCRS.Type())
+
+	sourceCRS, err := boundCRS.SourceCRS()
+	assert.NoError(t, err)
+	assert.Equal(t, "NAVD88 height", sourceCRS.Name())
+
+	targetCRS, err := boundCRS.TargetCRS()
+	assert.NoError(t, err)
+	assert.Equal(t, "WGS 84", targetCRS.Name())
+}
@@ -0,0 +1,158 @@
+package proj
+
+// #include "go-proj.h"
+// #cgo nocallback proj_crs_get_coordinate_system
+// #cgo nocallback proj_cs_get_axis_count
+// #cgo nocallback proj_cs_get_axis_info
+// #cgo nocallback proj_cs_get_type
+// #cgo nocallback proj_errno_reset
+// #cgo nocallback proj_errno_restore
+// #cgo noescape proj_crs_get_coordinate_system
+// #cgo noescape proj_cs_get_axis_count
+// #cgo noescape proj_cs_get_axis_info
+// #cgo noescape proj_cs_get_type
+// #cgo noescape proj_errno_reset
+// #cgo noescape proj_errno_restore
+import "C"
+
+import (
+	"runtime"
+)
+
+// An AxisOrder is the order of the first two axes of a CRS.
+type AxisOrder int
+
+// Axis orders.
+const (
+	AxisOrderUnknown AxisOrder = iota
+	AxisOrderLonLat            // Longitude, latitude or easting, northing.
+	AxisOrderLatLon            // Latitude, longitude or northing, easting.
+)
+
+// A CoordinateSystemType is the type of a coordinate system.
+type CoordinateSystemType C.PJ_COORDINATE_SYSTEM_TYPE
+
+// Coordinate system types.
+const (
+	CoordinateSystemTypeUnknown          CoordinateSystemType = C.PJ_CS_TYPE_UNKNOWN
+	CoordinateSystemTypeCartesian        CoordinateSystemType = C.PJ_CS_TYPE_CARTESIAN
+	CoordinateSystemTypeEllipsoidal      CoordinateSystemType = C.PJ_CS_TYPE_ELLIPSOIDAL
+	CoordinateSystemTypeVertical         CoordinateSystemType = C.PJ_CS_TYPE_VERTICAL
+	CoordinateSystemTypeSpherical        CoordinateSystemType = C.PJ_CS_TYPE_SPHERICAL
+	CoordinateSystemTypeOrdinal          CoordinateSystemType = C.PJ_CS_TYPE_ORDINAL
+	CoordinateSystemTypeParametric       CoordinateSystemType = C.PJ_CS_TYPE_PARAMETRIC
+	CoordinateSystemTypeDateTimeTemporal CoordinateSystemType = C.PJ_CS_TYPE_DATETIMETEMPORAL
+	CoordinateSystemTypeTemporalCount    CoordinateSystemType = C.PJ_CS_TYPE_TEMPORALCOUNT
+	CoordinateSystemTypeTemporalMeasure  CoordinateSystemType = C.PJ_CS_TYPE_TEMPORALMEASURE
+)
+
+// An Axis is an axis of a coordinate system.
+type Axis struct {
+	Name           string
+	Abbrev         string
+	Direction      string
+	UnitConvFactor float64
+	UnitName       string
+	UnitAuthName   string
+	UnitCode       string
+}
+
+// A CoordinateSystem is a coordinate system.
+type CoordinateSystem struct {
+	Type CoordinateSystemType
+	Axes []Axis
+}
+
+// AxisOrder returns the order of the first two axes of pj, which must be a
+// CRS.
+func (pj *PJ) AxisOrder() (AxisOrder, error) {
+	coordinateSystem, err := pj.CoordinateSystem()
+	if err != nil {
+		return AxisOrderUnknown, err
+	}
+	if len(coordinateSystem.Axes) < 2 {
+		return AxisOrderUnknown, nil
+	}
+	switch coordinateSystem.Axes[0].Direction {
+	case "east", "west":
+		return AxisOrderLonLat, nil
+	case "north", "south":
+		return AxisOrderLatLon, nil
+	default:
+		return AxisOrderUnknown, nil
+	}
+}
+
+// CoordinateSystem returns the coordinate system of pj, which must be a CRS.
+func (pj *PJ) CoordinateSystem() (CoordinateSystem, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	cs, err := pj.context.newPJ(C.proj_crs_get_coordinate_system(pj.context.cPJContext, pj.cPJ))
+	if err != nil {
+		return CoordinateSystem{}, err
+	}
+	defer runtime.KeepAlive(cs)
+
+	n := int(C.proj_cs_get_axis_count(pj.context.cPJContext, cs.cPJ))
+	if n < 0 {
+		return CoordinateSystem{}, pj.context.lastError()
+	}
+	axes := make([]Axis, n)
+	for i := range axes {
+		var name, abbrev, direction, unitName, unitAuthName, unitCode *C.char
+		var unitConvFactor C.double
+		if C.proj_cs_get_axis_info(pj.context.cPJContext, cs.cPJ, C.int(i), &name, &abbrev, &direction, &unitConvFactor, &unitName, &unitAuthName, &unitCode) == 0 {
+			return CoordinateSystem{}, pj.context.lastError()
+		}
+		axes[i] = Axis{
+			Name:           C.GoString(name),
+			Abbrev:         C.GoString(abbrev),
+			Direction:      C.GoString(direction),
+			UnitConvFactor: float64(unitConvFactor),
+			UnitName:       C.GoString(unitName),
+			UnitAuthName:   C.GoString(unitAuthName),
+			UnitCode:       C.GoString(unitCode),
+		}
+	}
+
+	return CoordinateSystem{
+		Type: CoordinateSystemType(C.proj_cs_get_type(pj.context.cPJContext, cs.cPJ)),
+		Axes: axes,
+	}, nil
+}
+
+// FromLonLat returns coord, whose first two elements are in longitude,
+// latitude order, in order o.
+func (o AxisOrder) FromLonLat(coord Coord) Coord {
+	if o == AxisOrderLatLon {
+		coord[0], coord[1] = coord[1], coord[0]
+	}
+	return coord
+}
+
+// FromLonLatArray converts coords, whose first two elements are in longitude,
+// latitude order, to order o in place.
+func (o AxisOrder) FromLonLatArray(coords []Coord) {
+	if o != AxisOrderLatLon {
+		return
+	}
+	for i := range coords {
+		coords[i][0], coords[i][1] = coords[i][1], coords[i][0]
+	}
+}
+
+// ToLonLat returns coord, whose first two elements are in order o, in
+// longitude, latitude order.
+func (o AxisOrder) ToLonLat(coord Coord) Coord {
+	return o.FromLonLat(coord)
+}
+
+// ToLonLatArray converts coords, whose first two elements are in order o, to
+// longitude, latitude order in place.
+func (o AxisOrder) ToLonLatArray(coords []Coord) {
+	o.FromLonLatArray(coords)
+}
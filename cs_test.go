@@ -0,0 +1,131 @@
+package proj_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-proj/v11"
+)
+
+func TestPJ_CoordinateSystem(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	for _, tc := range []struct {
+		definition               string
+		expectedCoordinateSystem proj.CoordinateSystem
+		expectedAxisOrder        proj.AxisOrder
+	}{
+		{
+			definition: "EPSG:4326",
+			expectedCoordinateSystem: proj.CoordinateSystem{
+				Type: proj.CoordinateSystemTypeEllipsoidal,
+				Axes: []proj.Axis{
+					{
+						Name:           "Geodetic latitude",
+						Abbrev:         "Lat",
+						Direction:      "north",
+						UnitConvFactor: 0.0174532925199433,
+						UnitName:       "degree",
+						UnitAuthName:   "EPSG",
+						UnitCode:       "9122",
+					},
+					{
+						Name:           "Geodetic longitude",
+						Abbrev:         "Lon",
+						Direction:      "east",
+						UnitConvFactor: 0.0174532925199433,
+						UnitName:       "degree",
+						UnitAuthName:   "EPSG",
+						UnitCode:       "9122",
+					},
+				},
+			},
+			expectedAxisOrder: proj.AxisOrderLatLon,
+		},
+		{
+			definition: "EPSG:3857",
+			expectedCoordinateSystem: proj.CoordinateSystem{
+				Type: proj.CoordinateSystemTypeCartesian,
+				Axes: []proj.Axis{
+					{
+						Name:           "Easting",
+						Abbrev:         "X",
+						Direction:      "east",
+						UnitConvFactor: 1,
+						UnitName:       "metre",
+						UnitAuthName:   "EPSG",
+						UnitCode:       "9001",
+					},
+					{
+						Name:           "Northing",
+						Abbrev:         "Y",
+						Direction:      "north",
+						UnitConvFactor: 1,
+						UnitName:       "metre",
+						UnitAuthName:   "EPSG",
+						UnitCode:       "9001",
+					},
+				},
+			},
+			expectedAxisOrder: proj.AxisOrderLonLat,
+		},
+	} {
+		t.Run(tc.definition, func(t *testing.T) {
+			crs, err := context.New(tc.definition)
+			assert.NoError(t, err)
+
+			actualCoordinateSystem, err := crs.CoordinateSystem()
+			assert.NoError(t, err)
+			assert.Equal(t, len(tc.expectedCoordinateSystem.Axes), len(actualCoordinateSystem.Axes))
+			for i, expectedAxis := range tc.expectedCoordinateSystem.Axes {
+				assertInDelta(t, expectedAxis.UnitConvFactor, actualCoordinateSystem.Axes[i].UnitConvFactor, 1e-15)
+				actualCoordinateSystem.Axes[i].UnitConvFactor = expectedAxis.UnitConvFactor
+			}
+			assert.Equal(t, tc.expectedCoordinateSystem, actualCoordinateSystem)
+
+			actualAxisOrder, err := crs.AxisOrder()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedAxisOrder, actualAxisOrder)
+		})
+	}
+}
+
+func TestPJ_CoordinateSystem_error(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	pj, err := context.NewCRSToCRS("EPSG:4326", "EPSG:3857", nil)
+	assert.NoError(t, err)
+
+	_, err = pj.CoordinateSystem()
+	assert.Error(t, err)
+
+	_, err = pj.AxisOrder()
+	assert.Error(t, err)
+}
+
+func TestAxisOrder(t *testing.T) {
+	lonLat := proj.Coord{8.541111, 47.374444, 408, 0}
+	latLon := proj.Coord{47.374444, 8.541111, 408, 0}
+
+	assert.Equal(t, lonLat, proj.AxisOrderLonLat.FromLonLat(lonLat))
+	assert.Equal(t, lonLat, proj.AxisOrderLonLat.ToLonLat(lonLat))
+	assert.Equal(t, latLon, proj.AxisOrderLatLon.FromLonLat(lonLat))
+	assert.Equal(t, lonLat, proj.AxisOrderLatLon.ToLonLat(latLon))
+	assert.Equal(t, lonLat, proj.AxisOrderUnknown.FromLonLat(lonLat))
+
+	coords := []proj.Coord{lonLat, lonLat}
+	proj.AxisOrderLatLon.FromLonLatArray(coords)
+	assert.Equal(t, []proj.Coord{latLon, latLon}, coords)
+	proj.AxisOrderLatLon.ToLonLatArray(coords)
+	assert.Equal(t, []proj.Coord{lonLat, lonLat}, coords)
+	proj.AxisOrderLonLat.FromLonLatArray(coords)
+	assert.Equal(t, []proj.Coord{lonLat, lonLat}, coords)
+}
@@ -0,0 +1,72 @@
+package proj
+
+// #include "go-proj.h"
+// #cgo nocallback proj_errno_reset
+// #cgo nocallback proj_errno_restore
+// #cgo nocallback proj_get_non_deprecated
+// #cgo nocallback proj_is_deprecated
+// #cgo noescape proj_errno_reset
+// #cgo noescape proj_errno_restore
+// #cgo noescape proj_get_non_deprecated
+// #cgo noescape proj_is_deprecated
+import "C"
+
+// IsDeprecated returns whether pj is deprecated.
+func (pj *PJ) IsDeprecated() bool {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+	return C.proj_is_deprecated(pj.cPJ) != 0
+}
+
+// NonDeprecated returns the non-deprecated objects that replace pj, which must
+// be a deprecated object from the database.
+func (pj *PJ) NonDeprecated() ([]*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	cList := C.proj_get_non_deprecated(pj.context.cPJContext, pj.cPJ)
+	if cList == nil {
+		return nil, pj.context.lastError()
+	}
+	return pj.context.newPJList(cList)
+}
+
+// NonDeprecatedCode returns the AUTH:CODE of the current replacement of the
+// object with the given AUTH:CODE. If the object is not deprecated then its own
+// AUTH:CODE is returned. It returns ErrNotFound if the object has no
+// replacement and ErrAmbiguous if it has more than one.
+func (c *Context) NonDeprecatedCode(code string) (string, error) {
+	pj, err := c.New(code)
+	if err != nil {
+		return "", err
+	}
+
+	replacement := pj
+	if pj.IsDeprecated() {
+		replacements, err := pj.NonDeprecated()
+		switch {
+		case err != nil:
+			return "", err
+		case len(replacements) == 0:
+			return "", ErrNotFound
+		case len(replacements) > 1:
+			return "", ErrAmbiguous
+		}
+		replacement = replacements[0]
+	}
+
+	authName, id := replacement.IDAuthName(0), replacement.IDCode(0)
+	if authName == "" || id == "" {
+		return "", ErrNotFound
+	}
+	return authName + ":" + id, nil
+}
+
+// NonDeprecatedCode returns the AUTH:CODE of the current replacement of the
+// object with the given AUTH:CODE.
+func NonDeprecatedCode(code string) (string, error) {
+	return defaultContext.NonDeprecatedCode(code)
+}
@@ -0,0 +1,65 @@
+package proj_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-proj/v11"
+)
+
+func TestPJ_NonDeprecated(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	crs, err := context.New("EPSG:3785")
+	assert.NoError(t, err)
+	assert.True(t, crs.IsDeprecated())
+
+	replacements, err := crs.NonDeprecated()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(replacements))
+	assert.False(t, replacements[0].IsDeprecated())
+	assert.Equal(t, "3857", replacements[0].IDCode(0))
+}
+
+func TestNonDeprecatedCode(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	for _, tc := range []struct {
+		code     string
+		expected string
+	}{
+		{
+			code:     "EPSG:3785",
+			expected: "EPSG:3857",
+		},
+		{
+			code:     "EPSG:3857",
+			expected: "EPSG:3857",
+		},
+		{
+			code:     "EPSG:2056",
+			expected: "EPSG:2056",
+		},
+	} {
+		t.Run(tc.code, func(t *testing.T) {
+			actual, err := context.NonDeprecatedCode(tc.code)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+
+	_, err := context.NonDeprecatedCode("EPSG:0")
+	assert.Error(t, err)
+
+	actual, err := proj.NonDeprecatedCode("EPSG:3785")
+	assert.NoError(t, err)
+	assert.Equal(t, "EPSG:3857", actual)
+}
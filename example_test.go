@@ -35,3 +35,37 @@ func ExamplePJ_Forward() {
 	// forward: x=950792.127329 y=6003408.475803 z=408.000000
 	// inverse: x=47.374444 y=8.541111 z=408.000000
 }
+
+func ExamplePJ_AxisOrder() {
+	sourceCRS, err := proj.New("EPSG:4326")
+	if err != nil {
+		panic(err)
+	}
+
+	targetCRS, err := proj.New("EPSG:3857")
+	if err != nil {
+		panic(err)
+	}
+
+	pj, err := proj.NewCRSToCRSFromPJ(sourceCRS, targetCRS, nil, "")
+	if err != nil {
+		panic(err)
+	}
+
+	// EPSG:4326 has latitude, longitude axis order.
+	axisOrder, err := sourceCRS.AxisOrder()
+	if err != nil {
+		panic(err)
+	}
+
+	// Convert Zürich's WGS84 longitude/latitude to Web Mercator.
+	zurich4326 := axisOrder.FromLonLat(proj.NewCoord(8.541111, 47.374444, 408, 0))
+	zurich3857, err := pj.Forward(zurich4326)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("forward: x=%.6f y=%.6f z=%.6f\n", zurich3857.X(), zurich3857.Y(), zurich3857.Z())
+
+	// Output:
+	// forward: x=950792.127329 y=6003408.475803 z=408.000000
+}
@@ -0,0 +1,132 @@
+// Package geod solves geodesic problems on an ellipsoid using the
+// GeographicLib implementation bundled with PROJ, so results match
+// proj.PJ.Geod. See https://geographiclib.sourceforge.io/C/doc/.
+//
+// All angles are in degrees and all distances are in meters.
+package geod
+
+// #cgo pkg-config: proj
+// #include <geodesic.h>
+// #cgo nocallback geod_direct
+// #cgo nocallback geod_directline
+// #cgo nocallback geod_init
+// #cgo nocallback geod_inverse
+// #cgo nocallback geod_inverseline
+// #cgo nocallback geod_lineinit
+// #cgo nocallback geod_position
+// #cgo noescape geod_direct
+// #cgo noescape geod_directline
+// #cgo noescape geod_init
+// #cgo noescape geod_inverse
+// #cgo noescape geod_inverseline
+// #cgo noescape geod_lineinit
+// #cgo noescape geod_position
+import "C"
+
+import (
+	"github.com/twpayne/go-proj/v11"
+)
+
+// lineCaps are the capabilities of a Line.
+const lineCaps = C.GEOD_LATITUDE | C.GEOD_LONGITUDE | C.GEOD_AZIMUTH | C.GEOD_DISTANCE_IN
+
+// WGS84 is the WGS84 ellipsoid.
+var WGS84 = New(6378137, 1/298.257223563)
+
+// A Geodesic is an ellipsoid on which geodesic problems are solved.
+type Geodesic struct {
+	cGeodesic C.struct_geod_geodesic
+}
+
+// A Line is a geodesic line from a starting point.
+type Line struct {
+	cLine C.struct_geod_geodesicline
+}
+
+// New returns a new Geodesic for the ellipsoid with equatorial radius a and
+// flattening f.
+func New(a, f float64) *Geodesic {
+	g := &Geodesic{}
+	C.geod_init(&g.cGeodesic, C.double(a), C.double(f))
+	return g
+}
+
+// Direct solves the direct geodesic problem. It returns the latitude,
+// longitude, and forward azimuth at the point s12 meters from (lat1, lon1)
+// along the geodesic with initial azimuth azi1.
+func (g *Geodesic) Direct(lat1, lon1, azi1, s12 float64) (float64, float64, float64) {
+	var lat2, lon2, azi2 C.double
+	C.geod_direct(&g.cGeodesic, C.double(lat1), C.double(lon1), C.double(azi1), C.double(s12), &lat2, &lon2, &azi2)
+	return float64(lat2), float64(lon2), float64(azi2)
+}
+
+// DirectLine returns the Line from (lat1, lon1) with initial azimuth azi1 and
+// length s12.
+func (g *Geodesic) DirectLine(lat1, lon1, azi1, s12 float64) *Line {
+	l := &Line{}
+	C.geod_directline(&l.cLine, &g.cGeodesic, C.double(lat1), C.double(lon1), C.double(azi1), C.double(s12), lineCaps)
+	return l
+}
+
+// Interpolate returns n evenly spaced points along the geodesic from (lat1,
+// lon1) to (lat2, lon2), including both end points. See Line.Positions.
+func (g *Geodesic) Interpolate(lat1, lon1, lat2, lon2 float64, n int) []proj.Coord {
+	return g.InverseLine(lat1, lon1, lat2, lon2).Positions(n)
+}
+
+// Inverse solves the inverse geodesic problem. It returns the distance
+// between (lat1, lon1) and (lat2, lon2), the forward azimuth at (lat1, lon1),
+// and the forward azimuth at (lat2, lon2).
+func (g *Geodesic) Inverse(lat1, lon1, lat2, lon2 float64) (float64, float64, float64) {
+	var s12, azi1, azi2 C.double
+	C.geod_inverse(&g.cGeodesic, C.double(lat1), C.double(lon1), C.double(lat2), C.double(lon2), &s12, &azi1, &azi2)
+	return float64(s12), float64(azi1), float64(azi2)
+}
+
+// InverseLine returns the Line from (lat1, lon1) to (lat2, lon2).
+func (g *Geodesic) InverseLine(lat1, lon1, lat2, lon2 float64) *Line {
+	l := &Line{}
+	C.geod_inverseline(&l.cLine, &g.cGeodesic, C.double(lat1), C.double(lon1), C.double(lat2), C.double(lon2), lineCaps)
+	return l
+}
+
+// Line returns the Line from (lat1, lon1) with initial azimuth azi1. The
+// returned Line has zero length.
+func (g *Geodesic) Line(lat1, lon1, azi1 float64) *Line {
+	l := &Line{}
+	C.geod_lineinit(&l.cLine, &g.cGeodesic, C.double(lat1), C.double(lon1), C.double(azi1), lineCaps)
+	return l
+}
+
+// Distance returns l's length.
+func (l *Line) Distance() float64 {
+	return float64(l.cLine.s13)
+}
+
+// Position returns the latitude, longitude, and forward azimuth at the point
+// s12 meters along l. s12 may be negative or greater than l's length.
+func (l *Line) Position(s12 float64) (float64, float64, float64) {
+	var lat2, lon2, azi2 C.double
+	C.geod_position(&l.cLine, C.double(s12), &lat2, &lon2, &azi2)
+	return float64(lat2), float64(lon2), float64(azi2)
+}
+
+// Positions returns n evenly spaced points along l, including both end points.
+// Each point is returned as a Coord with latitude and longitude as its first
+// two elements, matching the axis order of EPSG:4326.
+func (l *Line) Positions(n int) []proj.Coord {
+	if n <= 0 {
+		return nil
+	}
+	coords := make([]proj.Coord, n)
+	if n == 1 {
+		coords[0] = proj.Coord{float64(l.cLine.lat1), float64(l.cLine.lon1), 0, 0}
+		return coords
+	}
+	s13 := l.Distance()
+	for i := range coords {
+		lat, lon, _ := l.Position(s13 * float64(i) / float64(n-1))
+		coords[i] = proj.Coord{lat, lon, 0, 0}
+	}
+	return coords
+}
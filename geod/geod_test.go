@@ -0,0 +1,112 @@
+package geod_test
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-proj/v11"
+	"github.com/twpayne/go-proj/v11/geod"
+)
+
+func TestGeodesic_Inverse(t *testing.T) {
+	pj, err := proj.New("epsg:4326")
+	assert.NoError(t, err)
+
+	for i, tc := range []struct {
+		lat1, lon1, lat2, lon2 float64
+	}{
+		{lat1: 46.948056, lon1: 7.4475, lat2: 47.374444, lon2: 8.541111},
+		{lat1: 40.712778, lon1: -74.006111, lat2: 48.856613, lon2: 2.352222},
+		{lat1: -41.32, lon1: 174.81, lat2: 40.96, lon2: -5.5},
+	} {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			s12, azi1, azi2 := geod.WGS84.Inverse(tc.lat1, tc.lon1, tc.lat2, tc.lon2)
+			a := proj.NewCoord(tc.lon1, tc.lat1, 0, 0)
+			b := proj.NewCoord(tc.lon2, tc.lat2, 0, 0)
+			expectedS12, expectedAzi1, expectedAzi2 := pj.Geod(a.DegToRad(), b.DegToRad())
+			assertInDelta(t, expectedS12, s12, 1e-6)
+			assertInDelta(t, expectedAzi1, azi1, 1e-9)
+			assertInDelta(t, expectedAzi2, azi2, 1e-9)
+		})
+	}
+}
+
+func TestGeodesic_Inverse_wellingtonSalamanca(t *testing.T) {
+	// See https://geographiclib.sourceforge.io/Python/doc/examples.html.
+	s12, _, _ := geod.WGS84.Inverse(-41.32, 174.81, 40.96, -5.5)
+	assertInDelta(t, 19959679.267, s12, 1e-3)
+}
+
+func TestGeodesic_Direct(t *testing.T) {
+	for i, tc := range []struct {
+		lat1, lon1, lat2, lon2 float64
+	}{
+		{lat1: 40.64, lon1: -73.78, lat2: 51.47, lon2: -0.46},
+		{lat1: -33.87, lon1: 151.21, lat2: -41.29, lon2: 174.78},
+		{lat1: 64.13, lon1: -21.94, lat2: 61.22, lon2: -149.9},
+	} {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			s12, azi1, azi2 := geod.WGS84.Inverse(tc.lat1, tc.lon1, tc.lat2, tc.lon2)
+
+			lat2, lon2, actualAzi2 := geod.WGS84.Direct(tc.lat1, tc.lon1, azi1, s12)
+			assertInDelta(t, tc.lat2, lat2, 1e-9)
+			assertInDelta(t, tc.lon2, lon2, 1e-9)
+			assertInDelta(t, azi2, actualAzi2, 1e-9)
+
+			line := geod.WGS84.DirectLine(tc.lat1, tc.lon1, azi1, s12)
+			assertInDelta(t, s12, line.Distance(), 1e-6)
+			lat2, lon2, _ = line.Position(line.Distance())
+			assertInDelta(t, tc.lat2, lat2, 1e-9)
+			assertInDelta(t, tc.lon2, lon2, 1e-9)
+		})
+	}
+}
+
+func TestGeodesic_Interpolate(t *testing.T) {
+	const (
+		lat1, lon1 = 40.64, -73.78
+		lat2, lon2 = 1.36, 103.99
+		n          = 11
+	)
+
+	s13, _, _ := geod.WGS84.Inverse(lat1, lon1, lat2, lon2)
+
+	coords := geod.WGS84.Interpolate(lat1, lon1, lat2, lon2, n)
+	assert.Equal(t, n, len(coords))
+	assertInDelta(t, lat1, coords[0][0], 1e-9)
+	assertInDelta(t, lon1, coords[0][1], 1e-9)
+	assertInDelta(t, lat2, coords[n-1][0], 1e-9)
+	assertInDelta(t, lon2, coords[n-1][1], 1e-9)
+	for i := 1; i < n; i++ {
+		s12, _, _ := geod.WGS84.Inverse(coords[i-1][0], coords[i-1][1], coords[i][0], coords[i][1])
+		assertInDelta(t, s13/(n-1), s12, 1e-6)
+	}
+
+	assert.Equal(t, 0, len(geod.WGS84.Interpolate(lat1, lon1, lat2, lon2, 0)))
+	assert.Equal(t, []proj.Coord{{lat1, lon1, 0, 0}}, geod.WGS84.Interpolate(lat1, lon1, lat2, lon2, 1))
+}
+
+func TestLine_Position(t *testing.T) {
+	line := geod.WGS84.Line(0, 0, 90)
+	assert.Equal(t, 0., line.Distance())
+
+	lat, lon, azi := line.Position(1000e3)
+	assertInDelta(t, 0, lat, 1e-12)
+	assertInDelta(t, 8.983152841195214, lon, 1e-9)
+	assertInDelta(t, 90, azi, 1e-12)
+
+	lat, lon, azi = line.Position(-1000e3)
+	assertInDelta(t, 0, lat, 1e-12)
+	assertInDelta(t, -8.983152841195214, lon, 1e-9)
+	assertInDelta(t, 90, azi, 1e-12)
+}
+
+func assertInDelta(tb testing.TB, expected, actual, delta float64) {
+	tb.Helper()
+	if actualDelta := math.Abs(expected - actual); actualDelta > delta {
+		tb.Fatalf("Expected %e to be within %e of %e, but delta is %e", actual, delta, expected, actualDelta)
+	}
+}
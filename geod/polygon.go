@@ -0,0 +1,151 @@
+package geod
+
+// #include <geodesic.h>
+// #cgo nocallback geod_polygon_addedge
+// #cgo nocallback geod_polygon_addpoint
+// #cgo nocallback geod_polygon_clear
+// #cgo nocallback geod_polygon_compute
+// #cgo nocallback geod_polygon_init
+// #cgo nocallback geod_polygonarea
+// #cgo noescape geod_polygon_addedge
+// #cgo noescape geod_polygon_addpoint
+// #cgo noescape geod_polygon_clear
+// #cgo noescape geod_polygon_compute
+// #cgo noescape geod_polygon_init
+// #cgo noescape geod_polygonarea
+import "C"
+
+import (
+	"math"
+
+	"github.com/twpayne/go-proj/v11"
+)
+
+// A Polygon accumulates the vertices and edges of a polygon or polyline whose
+// edges are geodesics.
+type Polygon struct {
+	geodesic *Geodesic
+	cPolygon C.struct_geod_polygon
+}
+
+// NewPolygon returns a new empty Polygon on g. If polyline is true then the
+// Polygon is treated as an open polyline and only its length is computed.
+func (g *Geodesic) NewPolygon(polyline bool) *Polygon {
+	p := &Polygon{
+		geodesic: g,
+	}
+	C.geod_polygon_init(&p.cPolygon, cBool(polyline))
+	return p
+}
+
+// PolygonArea returns the signed area and the perimeter of the polygon with
+// vertices coords. The first two elements of each Coord are the latitude and
+// longitude. The area is positive if coords are counter-clockwise. The polygon
+// is closed automatically, so the last Coord need not repeat the first. Edges
+// may cross the antimeridian.
+func (g *Geodesic) PolygonArea(coords []proj.Coord) (float64, float64) {
+	lats := make([]float64, len(coords))
+	lons := make([]float64, len(coords))
+	for i, coord := range coords {
+		lats[i] = coord[0]
+		lons[i] = coord[1]
+	}
+	return g.polygonArea(lats, lons)
+}
+
+// PolygonAreaFlatCoords returns the signed area and the perimeter of the
+// polygon with vertices flatCoords. See PolygonArea.
+func (g *Geodesic) PolygonAreaFlatCoords(flatCoords []float64, stride int) (float64, float64) {
+	n := len(flatCoords) / stride
+	lats := make([]float64, n)
+	lons := make([]float64, n)
+	for i := range n {
+		lats[i] = flatCoords[i*stride]
+		lons[i] = flatCoords[i*stride+1]
+	}
+	return g.polygonArea(lats, lons)
+}
+
+// PolygonWithHolesArea returns the area and the perimeter of the polygon whose
+// outer ring is rings[0] and whose holes are rings[1:]. The area is the area
+// of the outer ring less the areas of the holes, regardless of the orientation
+// of the rings. The perimeter is the sum of the perimeters of all rings.
+func (g *Geodesic) PolygonWithHolesArea(rings [][]proj.Coord) (float64, float64) {
+	var area, perimeter float64
+	for i, ring := range rings {
+		ringArea, ringPerimeter := g.PolygonArea(ring)
+		area += holeSign(i) * math.Abs(ringArea)
+		perimeter += ringPerimeter
+	}
+	return area, perimeter
+}
+
+// PolygonWithHolesAreaFlatCoords returns the area and the perimeter of the
+// polygon with rings in flatCoords, where ends contains the end offsets of
+// each ring. See PolygonWithHolesArea.
+func (g *Geodesic) PolygonWithHolesAreaFlatCoords(flatCoords []float64, ends []int, stride int) (float64, float64) {
+	var area, perimeter float64
+	offset := 0
+	for i, end := range ends {
+		ringArea, ringPerimeter := g.PolygonAreaFlatCoords(flatCoords[offset:end], stride)
+		area += holeSign(i) * math.Abs(ringArea)
+		perimeter += ringPerimeter
+		offset = end
+	}
+	return area, perimeter
+}
+
+// polygonArea returns the signed area and perimeter of the polygon with
+// vertices lats and lons.
+func (g *Geodesic) polygonArea(lats, lons []float64) (float64, float64) {
+	if len(lats) == 0 {
+		return 0, 0
+	}
+	var area, perimeter C.double
+	C.geod_polygonarea(&g.cGeodesic, (*C.double)(&lats[0]), (*C.double)(&lons[0]), C.int(len(lats)), &area, &perimeter)
+	return float64(area), float64(perimeter)
+}
+
+// AddEdge adds an edge with azimuth azi and length s to p, starting at the
+// last point added.
+func (p *Polygon) AddEdge(azi, s float64) {
+	C.geod_polygon_addedge(&p.geodesic.cGeodesic, &p.cPolygon, C.double(azi), C.double(s))
+}
+
+// AddPoint adds the point (lat, lon) to p.
+func (p *Polygon) AddPoint(lat, lon float64) {
+	C.geod_polygon_addpoint(&p.geodesic.cGeodesic, &p.cPolygon, C.double(lat), C.double(lon))
+}
+
+// Clear removes all points from p.
+func (p *Polygon) Clear() {
+	C.geod_polygon_clear(&p.cPolygon)
+}
+
+// Compute returns the area, the perimeter, and the number of points of p. The
+// area is positive if p is counter-clockwise, or clockwise if reverse is true.
+// If signed is true then traversing p in the opposite direction gives a
+// negative area, otherwise it gives the area of the rest of the ellipsoid. The
+// area of a polyline is always zero.
+func (p *Polygon) Compute(reverse, signed bool) (float64, float64, int) {
+	var area, perimeter C.double
+	n := C.geod_polygon_compute(&p.geodesic.cGeodesic, &p.cPolygon, cBool(reverse), cBool(signed), &area, &perimeter)
+	return float64(area), float64(perimeter), int(n)
+}
+
+// cBool returns b as a C int.
+func cBool(b bool) C.int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// holeSign returns the sign of the contribution of the area of the ith ring
+// of a polygon.
+func holeSign(i int) float64 {
+	if i == 0 {
+		return 1
+	}
+	return -1
+}
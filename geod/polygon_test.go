@@ -0,0 +1,96 @@
+package geod_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-proj/v11"
+	"github.com/twpayne/go-proj/v11/geod"
+)
+
+func TestGeodesic_PolygonArea(t *testing.T) {
+	square := []proj.Coord{{0, 0}, {0, 1}, {1, 1}, {1, 0}}
+
+	// A one degree square at the equator is about 111 km by 111 km.
+	area, perimeter := geod.WGS84.PolygonArea(square)
+	assertInDelta(t, 1.2309e10, area, 1e7)
+	assertInDelta(t, 443770, perimeter, 1e3)
+
+	reversedArea, reversedPerimeter := geod.WGS84.PolygonArea([]proj.Coord{{0, 0}, {1, 0}, {1, 1}, {0, 1}})
+	assertInDelta(t, -area, reversedArea, 1e-3)
+	assertInDelta(t, perimeter, reversedPerimeter, 1e-6)
+
+	closedArea, closedPerimeter := geod.WGS84.PolygonArea(append(square, square[0]))
+	assertInDelta(t, area, closedArea, 1e-3)
+	assertInDelta(t, perimeter, closedPerimeter, 1e-6)
+
+	flatArea, flatPerimeter := geod.WGS84.PolygonAreaFlatCoords([]float64{0, 0, 9, 0, 1, 9, 1, 1, 9, 1, 0, 9}, 3)
+	assert.Equal(t, area, flatArea)
+	assert.Equal(t, perimeter, flatPerimeter)
+
+	emptyArea, emptyPerimeter := geod.WGS84.PolygonArea(nil)
+	assert.Equal(t, 0., emptyArea)
+	assert.Equal(t, 0., emptyPerimeter)
+}
+
+func TestGeodesic_PolygonArea_antimeridian(t *testing.T) {
+	expectedArea, expectedPerimeter := geod.WGS84.PolygonArea([]proj.Coord{{0, -1}, {1, -1}, {1, 1}, {0, 1}})
+	actualArea, actualPerimeter := geod.WGS84.PolygonArea([]proj.Coord{{0, 179}, {1, 179}, {1, -179}, {0, -179}})
+	assertInDelta(t, expectedArea, actualArea, 1e-3)
+	assertInDelta(t, expectedPerimeter, actualPerimeter, 1e-6)
+}
+
+func TestGeodesic_PolygonWithHolesArea(t *testing.T) {
+	outer := []proj.Coord{{0, 0}, {2, 0}, {2, 2}, {0, 2}}
+	hole := []proj.Coord{{0.5, 0.5}, {0.5, 1.5}, {1.5, 1.5}, {1.5, 0.5}}
+
+	outerArea, outerPerimeter := geod.WGS84.PolygonArea(outer)
+	holeArea, holePerimeter := geod.WGS84.PolygonArea(hole)
+	assert.True(t, outerArea < 0)
+	assert.True(t, holeArea > 0)
+
+	area, perimeter := geod.WGS84.PolygonWithHolesArea([][]proj.Coord{outer, hole})
+	assertInDelta(t, math.Abs(outerArea)-math.Abs(holeArea), area, 1e-3)
+	assertInDelta(t, outerPerimeter+holePerimeter, perimeter, 1e-6)
+
+	var flatCoords []float64
+	var ends []int
+	for _, ring := range [][]proj.Coord{outer, hole} {
+		for _, coord := range ring {
+			flatCoords = append(flatCoords, coord[0], coord[1])
+		}
+		ends = append(ends, len(flatCoords))
+	}
+	flatArea, flatPerimeter := geod.WGS84.PolygonWithHolesAreaFlatCoords(flatCoords, ends, 2)
+	assert.Equal(t, area, flatArea)
+	assert.Equal(t, perimeter, flatPerimeter)
+}
+
+func TestPolygon(t *testing.T) {
+	expectedArea, expectedPerimeter := geod.WGS84.PolygonArea([]proj.Coord{{0, 0}, {1, 0}, {1, 1}, {0, 1}})
+
+	polygon := geod.WGS84.NewPolygon(false)
+	polygon.AddPoint(0, 0)
+	polygon.AddPoint(1, 0)
+	polygon.AddPoint(1, 1)
+	polygon.AddPoint(0, 1)
+	area, perimeter, n := polygon.Compute(false, true)
+	assert.Equal(t, expectedArea, area)
+	assert.Equal(t, expectedPerimeter, perimeter)
+	assert.Equal(t, 4, n)
+
+	polygon.Clear()
+	_, _, n = polygon.Compute(false, true)
+	assert.Equal(t, 0, n)
+
+	polyline := geod.WGS84.NewPolygon(true)
+	polyline.AddPoint(0, 0)
+	polyline.AddEdge(90, 1000)
+	polyline.AddEdge(0, 1000)
+	area, perimeter, n = polyline.Compute(false, true)
+	assert.Equal(t, 0., area)
+	assertInDelta(t, 2000, perimeter, 1e-9)
+	assert.Equal(t, 3, n)
+}
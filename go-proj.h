@@ -3,6 +3,42 @@
 
 #include <proj.h>
 
+#if PROJ_VERSION_MAJOR < 6 ||                                                  \
+    (PROJ_VERSION_MAJOR == 6 && PROJ_VERSION_MINOR < 3)
+#define PJ_WKT2_2019 PJ_WKT2_2018
+#define PJ_WKT2_2019_SIMPLIFIED PJ_WKT2_2018_SIMPLIFIED
+PJ *proj_coordoperation_create_inverse(PJ_CONTEXT *ctx, const PJ *obj);
+PJ *proj_crs_create_bound_vertical_crs(PJ_CONTEXT *ctx, const PJ *vert_crs,
+                                        const PJ *hub_geographic_3D_crs,
+                                        const char *grid_name);
+PJ *proj_crs_create_projected_3D_crs_from_2D(PJ_CONTEXT *ctx,
+                                             const char *crs_name,
+                                             const PJ *projected_2D_crs,
+                                             const PJ *geog_3D_crs);
+PJ *proj_crs_promote_to_3D(PJ_CONTEXT *ctx, const char *crs_3D_name,
+                           const PJ *crs_2D);
+int proj_is_equivalent_to_with_ctx(PJ_CONTEXT *ctx, const PJ *obj,
+                                   const PJ *other,
+                                   PJ_COMPARISON_CRITERION criterion);
+#endif
+
+#if PROJ_VERSION_MAJOR < 7
+PJ *proj_crs_demote_to_2D(PJ_CONTEXT *ctx, const char *crs_2D_name,
+                          const PJ *crs_3D);
+#endif
+
+#if PROJ_VERSION_MAJOR < 7 ||                                                  \
+    (PROJ_VERSION_MAJOR == 7 && PROJ_VERSION_MINOR < 2)
+PJ *proj_crs_get_datum_ensemble(PJ_CONTEXT *ctx, const PJ *crs);
+PJ *proj_crs_get_datum_forced(PJ_CONTEXT *ctx, const PJ *crs);
+double proj_datum_ensemble_get_accuracy(PJ_CONTEXT *ctx,
+                                        const PJ *datum_ensemble);
+PJ *proj_datum_ensemble_get_member(PJ_CONTEXT *ctx, const PJ *datum_ensemble,
+                                   int member_index);
+int proj_datum_ensemble_get_member_count(PJ_CONTEXT *ctx,
+                                         const PJ *datum_ensemble);
+#endif
+
 #if PROJ_VERSION_MAJOR < 8
 const char *proj_context_errno_string(PJ_CONTEXT *ctx, int err);
 #endif
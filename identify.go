@@ -0,0 +1,86 @@
+package proj
+
+// #include <stdlib.h>
+// #include "go-proj.h"
+// #cgo nocallback proj_errno_reset
+// #cgo nocallback proj_errno_restore
+// #cgo nocallback proj_get_id_auth_name
+// #cgo nocallback proj_get_id_code
+// #cgo nocallback proj_identify
+// #cgo nocallback proj_int_list_destroy
+// #cgo noescape proj_errno_reset
+// #cgo noescape proj_errno_restore
+// #cgo noescape proj_get_id_auth_name
+// #cgo noescape proj_get_id_code
+// #cgo noescape proj_identify
+// #cgo noescape proj_int_list_destroy
+import "C"
+
+import (
+	"unsafe"
+)
+
+// An IdentifyMatch is a candidate match returned by Identify.
+type IdentifyMatch struct {
+	PJ         *PJ
+	AuthName   string
+	Code       string
+	Confidence int
+}
+
+// IDAuthName returns the authority name of pj's identifier at index, or the
+// empty string if there is no such identifier.
+func (pj *PJ) IDAuthName(index int) string {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+	return C.GoString(C.proj_get_id_auth_name(pj.cPJ, C.int(index)))
+}
+
+// IDCode returns the code of pj's identifier at index, or the empty string if
+// there is no such identifier.
+func (pj *PJ) IDCode(index int) string {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+	return C.GoString(C.proj_get_id_code(pj.cPJ, C.int(index)))
+}
+
+// Identify returns the CRSs in the database that match pj, which must be a
+// CRS, sorted by decreasing confidence. The confidence is a percentage. If
+// authName is empty then all authorities are searched.
+func (pj *PJ) Identify(authName string) ([]IdentifyMatch, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	cAuthName := cStringOrNil(authName)
+	defer C.free(unsafe.Pointer(cAuthName))
+
+	var cConfidence *C.int
+	cList := C.proj_identify(pj.context.cPJContext, pj.cPJ, cAuthName, nil, &cConfidence)
+	if cList == nil {
+		return nil, pj.context.lastError()
+	}
+	defer C.proj_int_list_destroy(cConfidence)
+
+	pjs, err := pj.context.newPJList(cList)
+	if err != nil {
+		return nil, err
+	}
+	if len(pjs) == 0 {
+		return nil, nil
+	}
+
+	confidences := unsafe.Slice(cConfidence, len(pjs))
+	matches := make([]IdentifyMatch, len(pjs))
+	for i, match := range pjs {
+		matches[i] = IdentifyMatch{
+			PJ:         match,
+			AuthName:   C.GoString(C.proj_get_id_auth_name(match.cPJ, 0)),
+			Code:       C.GoString(C.proj_get_id_code(match.cPJ, 0)),
+			Confidence: int(confidences[i]),
+		}
+	}
+	return matches, nil
+}
@@ -0,0 +1,45 @@
+package proj_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-proj/v11"
+)
+
+func TestPJ_Identify(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	crs, err := context.New(`GEOGCS["GCS_WGS_1984",DATUM["D_WGS_1984",SPHEROID["WGS_1984",6378137.0,298.257223563]],PRIMEM["Greenwich",0.0],UNIT["Degree",0.0174532925199433]]`)
+	assert.NoError(t, err)
+	assert.Equal(t, "", crs.IDCode(0))
+
+	matches, err := crs.Identify("EPSG")
+	assert.NoError(t, err)
+	assert.NotZero(t, matches)
+	assert.Equal(t, "EPSG", matches[0].AuthName)
+	assert.Equal(t, "4326", matches[0].Code)
+	assert.True(t, matches[0].Confidence >= 70)
+	assert.Equal(t, "WGS 84", matches[0].PJ.Name())
+	assert.Equal(t, "EPSG", matches[0].PJ.IDAuthName(0))
+	assert.Equal(t, "4326", matches[0].PJ.IDCode(0))
+}
+
+func TestPJ_IDCode(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	crs, err := context.New("EPSG:2056")
+	assert.NoError(t, err)
+	assert.Equal(t, "EPSG", crs.IDAuthName(0))
+	assert.Equal(t, "2056", crs.IDCode(0))
+	assert.Equal(t, "", crs.IDAuthName(1))
+	assert.Equal(t, "", crs.IDCode(1))
+}
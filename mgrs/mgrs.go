@@ -0,0 +1,230 @@
+// Package mgrs converts between longitude and latitude and Military Grid
+// Reference System (MGRS) references, also known as US National Grid (USNG)
+// references. References in the polar regions use the Universal Polar
+// Stereographic (UPS) projection.
+package mgrs
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/twpayne/go-proj/v11/ups"
+	"github.com/twpayne/go-proj/v11/utm"
+)
+
+// A Precision is the precision of an MGRS reference.
+type Precision int
+
+// Precisions.
+const (
+	Precision100km Precision = iota
+	Precision10km
+	Precision1km
+	Precision100m
+	Precision10m
+	Precision1m
+)
+
+const tile = 100_000
+
+var (
+	errOutOfRange = errors.New("out of range")
+	errSyntax     = errors.New("syntax error")
+
+	referenceRegexp = regexp.MustCompile(`\A(\d{1,2})?([A-Z])([A-Z])([A-Z])(\d*)\z`)
+
+	utmBandLetters   = "CDEFGHJKLMNPQRSTUVWX"
+	utmColumnLetters = [...]string{"ABCDEFGH", "JKLMNPQR", "STUVWXYZ"}
+	utmRowLetters    = "ABCDEFGHJKLMNPQRSTUV"
+
+	// utmMinNorthings are the minimum northings of each latitude band, rounded
+	// down to a multiple of tile, indexed by the band's index in
+	// utmBandLetters.
+	utmMinNorthings = [...]float64{
+		1_100_000, 2_000_000, 2_800_000, 3_700_000, 4_600_000,
+		5_500_000, 6_400_000, 7_300_000, 8_200_000, 9_100_000,
+		0, 800_000, 1_700_000, 2_600_000, 3_500_000,
+		4_400_000, 5_300_000, 6_200_000, 7_000_000, 7_900_000,
+	}
+
+	// UPS bands, columns, and rows are indexed by 2*north + east. The minimum
+	// indexes are in units of tile.
+	upsBandLetters   = "ABYZ"
+	upsColumnLetters = [...]string{"JKLPQRSTUXYZ", "ABCFGHJKLPQR", "RSTUXYZ", "ABCFGHJ"}
+	upsRowLetters    = [...]string{"ABCDEFGHJKLMNPQRSTUVWXYZ", "ABCDEFGHJKLMNP"}
+	upsMinIndexes    = [...]int{8, 13}
+	upsEastIndex     = 20
+)
+
+// Forward returns the MGRS reference of (lon, lat) with precision.
+func Forward(lon, lat float64, precision Precision) (string, error) {
+	if precision < Precision100km || Precision1m < precision {
+		return "", fmt.Errorf("precision %d: %w", precision, errOutOfRange)
+	}
+	if lat < -90 || 90 < lat {
+		return "", fmt.Errorf("latitude %f: %w", lat, errOutOfRange)
+	}
+
+	var prefix string
+	var e, n float64
+	if band, err := utm.Band(lat); err == nil {
+		utmCoord, err := utm.Forward(lon, lat)
+		if err != nil {
+			return "", err
+		}
+		e, n = utmCoord.E, utmCoord.N
+		column := int(math.Floor(e/tile)) - 1
+		row := int(math.Floor(n / tile))
+		if utmCoord.Zone%2 == 0 {
+			row += 5
+		}
+		columnLetters := utmColumnLetters[(utmCoord.Zone-1)%3]
+		if column < 0 || len(columnLetters) <= column {
+			return "", fmt.Errorf("easting %f: %w", e, errOutOfRange)
+		}
+		prefix = strconv.Itoa(utmCoord.Zone) + string([]byte{band, columnLetters[column], utmRowLetters[row%len(utmRowLetters)]})
+	} else {
+		upsCoord, err := ups.Forward(lon, lat)
+		if err != nil {
+			return "", err
+		}
+		e, n = upsCoord.E, upsCoord.N
+		northIndex := boolIndex(upsCoord.Hemisphere == utm.HemisphereNorth)
+		columnIndex := int(math.Floor(e / tile))
+		eastIndex := boolIndex(columnIndex >= upsEastIndex)
+		band := 2*northIndex + eastIndex
+		if eastIndex == 1 {
+			columnIndex -= upsEastIndex
+		} else {
+			columnIndex -= upsMinIndexes[northIndex]
+		}
+		rowIndex := int(math.Floor(n/tile)) - upsMinIndexes[northIndex]
+		if columnIndex < 0 || len(upsColumnLetters[band]) <= columnIndex || rowIndex < 0 || len(upsRowLetters[northIndex]) <= rowIndex {
+			return "", fmt.Errorf("latitude %f: %w", lat, errOutOfRange)
+		}
+		prefix = string([]byte{upsBandLetters[band], upsColumnLetters[band][columnIndex], upsRowLetters[northIndex][rowIndex]})
+	}
+
+	if precision == Precision100km {
+		return prefix, nil
+	}
+	resolution := precision.resolution()
+	digits := int(precision)
+	eDigits := int(math.Floor(math.Mod(e, tile) / resolution))
+	nDigits := int(math.Floor(math.Mod(n, tile) / resolution))
+	return fmt.Sprintf("%s%0*d%0*d", prefix, digits, eDigits, digits, nDigits), nil
+}
+
+// Inverse returns the longitude and latitude of the center of the square
+// referenced by the MGRS reference s. s is case insensitive and may contain
+// spaces.
+func Inverse(s string) (float64, float64, error) {
+	normalized := strings.ToUpper(strings.Join(strings.Fields(s), ""))
+	m := referenceRegexp.FindStringSubmatch(normalized)
+	if m == nil || len(m[5])%2 != 0 || len(m[5]) > 2*int(Precision1m) {
+		return 0, 0, fmt.Errorf("%q: %w", s, errSyntax)
+	}
+	bandLetter, columnLetter, rowLetter := m[2][0], m[3][0], m[4][0]
+
+	precision := Precision(len(m[5]) / 2)
+	resolution := precision.resolution()
+	var de, dn float64
+	if precision > Precision100km {
+		eDigits, err := strconv.Atoi(m[5][:precision])
+		if err != nil {
+			return 0, 0, fmt.Errorf("%q: %w", s, err)
+		}
+		nDigits, err := strconv.Atoi(m[5][precision:])
+		if err != nil {
+			return 0, 0, fmt.Errorf("%q: %w", s, err)
+		}
+		de, dn = float64(eDigits)*resolution, float64(nDigits)*resolution
+	}
+	de += resolution / 2
+	dn += resolution / 2
+
+	if m[1] == "" {
+		band := strings.IndexByte(upsBandLetters, bandLetter)
+		if band < 0 {
+			return 0, 0, fmt.Errorf("%q: band %c: %w", s, bandLetter, errSyntax)
+		}
+		northIndex, eastIndex := band/2, band%2
+		columnIndex := strings.IndexByte(upsColumnLetters[band], columnLetter)
+		if columnIndex < 0 {
+			return 0, 0, fmt.Errorf("%q: column %c: %w", s, columnLetter, errSyntax)
+		}
+		if eastIndex == 1 {
+			columnIndex += upsEastIndex
+		} else {
+			columnIndex += upsMinIndexes[northIndex]
+		}
+		rowIndex := strings.IndexByte(upsRowLetters[northIndex], rowLetter)
+		if rowIndex < 0 {
+			return 0, 0, fmt.Errorf("%q: row %c: %w", s, rowLetter, errSyntax)
+		}
+		rowIndex += upsMinIndexes[northIndex]
+		hemisphere := utm.HemisphereSouth
+		if northIndex == 1 {
+			hemisphere = utm.HemisphereNorth
+		}
+		return ups.Inverse(ups.Coord{
+			Hemisphere: hemisphere,
+			E:          float64(columnIndex)*tile + de,
+			N:          float64(rowIndex)*tile + dn,
+		})
+	}
+
+	zone, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("%q: %w", s, err)
+	}
+	if zone < 1 || 60 < zone {
+		return 0, 0, fmt.Errorf("%q: zone %d: %w", s, zone, errOutOfRange)
+	}
+	band := strings.IndexByte(utmBandLetters, bandLetter)
+	if band < 0 {
+		return 0, 0, fmt.Errorf("%q: band %c: %w", s, bandLetter, errSyntax)
+	}
+	column := strings.IndexByte(utmColumnLetters[(zone-1)%3], columnLetter)
+	if column < 0 {
+		return 0, 0, fmt.Errorf("%q: column %c: %w", s, columnLetter, errSyntax)
+	}
+	row := strings.IndexByte(utmRowLetters, rowLetter)
+	if row < 0 {
+		return 0, 0, fmt.Errorf("%q: row %c: %w", s, rowLetter, errSyntax)
+	}
+	if zone%2 == 0 {
+		row = (row + len(utmRowLetters) - 5) % len(utmRowLetters)
+	}
+	n := float64(row) * tile
+	for n < utmMinNorthings[band] {
+		n += float64(len(utmRowLetters)) * tile
+	}
+	hemisphere := utm.HemisphereNorth
+	if bandLetter < 'N' {
+		hemisphere = utm.HemisphereSouth
+	}
+	return utm.Inverse(utm.Coord{
+		Zone:       zone,
+		Hemisphere: hemisphere,
+		E:          float64(column+1)*tile + de,
+		N:          n + dn,
+	})
+}
+
+// resolution returns the size of a square at p in meters.
+func (p Precision) resolution() float64 {
+	return math.Pow10(int(Precision1m - p))
+}
+
+// boolIndex returns 1 if b is true, or 0 otherwise.
+func boolIndex(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
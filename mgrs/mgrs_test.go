@@ -0,0 +1,167 @@
+package mgrs_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-proj/v11/mgrs"
+)
+
+func TestForward(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		lon, lat  float64
+		precision mgrs.Precision
+		expected  string
+	}{
+		{name: "Baghdad", lon: 44.4, lat: 33.3, precision: mgrs.Precision1m, expected: "38SMB4414084706"},
+		{name: "Washington Monument", lon: -77.035278, lat: 38.889484, precision: mgrs.Precision1m, expected: "18SUJ2347906481"},
+		{name: "CN Tower", lon: -79.3871, lat: 43.6426, precision: mgrs.Precision1m, expected: "17TPJ3008733442"},
+		{name: "Sydney Opera House", lon: 151.2153, lat: -33.8568, precision: mgrs.Precision1m, expected: "56HLH3490052288"},
+		{name: "Cape Town", lon: 18.4241, lat: -33.9249, precision: mgrs.Precision1m, expected: "34HBH6188143182"},
+		{name: "Rio de Janeiro", lon: -43.199158, lat: -22.911851, precision: mgrs.Precision1m, expected: "23KPQ8469365108"},
+		{name: "Null Island", lon: 0, lat: 0, precision: mgrs.Precision1m, expected: "31NAA6602100000"},
+		{name: "Just south of the equator", lon: 0.5, lat: -0.0001, precision: mgrs.Precision1m, expected: "31MBV2172399988"},
+		{name: "Bergen", lon: 5.3221, lat: 60.3913, precision: mgrs.Precision1m, expected: "32VKN9735300648"},
+		{name: "Longyearbyen", lon: 15.6356, lat: 78.2232, precision: mgrs.Precision1m, expected: "33XWG1448183357"},
+		{name: "Ny-Alesund", lon: 11.9222, lat: 78.925, precision: mgrs.Precision1m, expected: "33XVH3402763342"},
+		{name: "Svalbard zone 31X", lon: 8, lat: 78, precision: mgrs.Precision1m, expected: "31XFG1591463320"},
+		{name: "Svalbard zone 35X", lon: 25, lat: 80, precision: mgrs.Precision1m, expected: "35XMJ6123582252"},
+		{name: "Svalbard zone 35X west", lon: 22, lat: 78, precision: mgrs.Precision1m, expected: "35XLG8408563320"},
+		{name: "Svalbard zone 35X east of 30°E", lon: 31.5, lat: 78, precision: mgrs.Precision1m, expected: "35XPG0434662379"},
+		{name: "Svalbard zone 37X", lon: 34, lat: 78, precision: mgrs.Precision1m, expected: "37XCG8408563320"},
+		{name: "Ushuaia", lon: -68.303, lat: -54.8019, precision: mgrs.Precision1m, expected: "19FEV4480527029"},
+		{name: "Antarctic band C", lon: 166.6667, lat: -77.85, precision: mgrs.Precision1m, expected: "58CEU3915457813"},
+		{name: "Zone 60 band X", lon: 179.9, lat: 83.9, precision: mgrs.Precision1m, expected: "60XWU3439017795"},
+		{name: "Zone 1 band C", lon: -179.9, lat: -79.9, precision: mgrs.Precision1m, expected: "1CDM4324728161"},
+		{name: "UPS north west", lon: -45, lat: 85, precision: mgrs.Precision1m, expected: "YUD0723207232"},
+		{name: "UPS north east", lon: 45, lat: 85, precision: mgrs.Precision1m, expected: "ZFD9276707232"},
+		{name: "UPS south west", lon: -45, lat: -85, precision: mgrs.Precision1m, expected: "AUR0723292767"},
+		{name: "UPS south east", lon: 135, lat: -88, precision: mgrs.Precision1m, expected: "BBL5702642973"},
+		{name: "Alert", lon: -62.35, lat: 84.5, precision: mgrs.Precision1m, expected: "YSE5870416415"},
+		{name: "Baghdad 100km", lon: 44.4, lat: 33.3, precision: mgrs.Precision100km, expected: "38SMB"},
+		{name: "Baghdad 10km", lon: 44.4, lat: 33.3, precision: mgrs.Precision10km, expected: "38SMB48"},
+		{name: "Baghdad 1km", lon: 44.4, lat: 33.3, precision: mgrs.Precision1km, expected: "38SMB4484"},
+		{name: "Baghdad 100m", lon: 44.4, lat: 33.3, precision: mgrs.Precision100m, expected: "38SMB441847"},
+		{name: "Baghdad 10m", lon: 44.4, lat: 33.3, precision: mgrs.Precision10m, expected: "38SMB44148470"},
+		{name: "UPS south west 100km", lon: -45, lat: -85, precision: mgrs.Precision100km, expected: "AUR"},
+		{name: "UPS south west 10km", lon: -45, lat: -85, precision: mgrs.Precision10km, expected: "AUR09"},
+		{name: "UPS south west 1km", lon: -45, lat: -85, precision: mgrs.Precision1km, expected: "AUR0792"},
+		{name: "UPS south west 100m", lon: -45, lat: -85, precision: mgrs.Precision100m, expected: "AUR072927"},
+		{name: "UPS south west 10m", lon: -45, lat: -85, precision: mgrs.Precision10m, expected: "AUR07239276"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := mgrs.Forward(tc.lon, tc.lat, tc.precision)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestForward_errors(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		lon, lat  float64
+		precision mgrs.Precision
+	}{
+		{name: "negative precision", lon: 0, lat: 0, precision: -1},
+		{name: "too precise", lon: 0, lat: 0, precision: mgrs.Precision1m + 1},
+		{name: "latitude too large", lon: 0, lat: 91, precision: mgrs.Precision1m},
+		{name: "latitude too small", lon: 0, lat: -91, precision: mgrs.Precision1m},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := mgrs.Forward(tc.lon, tc.lat, tc.precision)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestInverse(t *testing.T) {
+	for _, tc := range []struct {
+		s                        string
+		expectedLon, expectedLat float64
+		delta                    float64
+	}{
+		{s: "38SMB4414084706", expectedLon: 44.4, expectedLat: 33.3, delta: 1e-5},
+		{s: "38smb 44140 84706", expectedLon: 44.4, expectedLat: 33.3, delta: 1e-5},
+		{s: "38SMB4484", expectedLon: 44.4, expectedLat: 33.3, delta: 1e-2},
+		{s: "56HLH3490052288", expectedLon: 151.2153, expectedLat: -33.8568, delta: 1e-5},
+		{s: "31NAA6602100000", expectedLon: 0, expectedLat: 0, delta: 1e-5},
+		{s: "31MBV2172399988", expectedLon: 0.5, expectedLat: -0.0001, delta: 1e-5},
+		{s: "33XWG1448183357", expectedLon: 15.6356, expectedLat: 78.2232, delta: 1e-4},
+		{s: "35XPG0434662379", expectedLon: 31.5, expectedLat: 78, delta: 1e-4},
+		{s: "37XCG8408563320", expectedLon: 34, expectedLat: 78, delta: 1e-4},
+		{s: "1CDM4324728161", expectedLon: -179.9, expectedLat: -79.9, delta: 1e-4},
+		{s: "YUD0723207232", expectedLon: -45, expectedLat: 85, delta: 1e-4},
+		{s: "ZFD9276707232", expectedLon: 45, expectedLat: 85, delta: 1e-4},
+		{s: "AUR0723292767", expectedLon: -45, expectedLat: -85, delta: 1e-4},
+		{s: "BBL5702642973", expectedLon: 135, expectedLat: -88, delta: 1e-3},
+	} {
+		t.Run(tc.s, func(t *testing.T) {
+			actualLon, actualLat, err := mgrs.Inverse(tc.s)
+			assert.NoError(t, err)
+			assertWithin(t, tc.delta, tc.expectedLon, actualLon)
+			assertWithin(t, tc.delta, tc.expectedLat, actualLat)
+		})
+	}
+
+	for _, s := range []string{
+		"ZAH0000000000",
+		"BAN0000000000",
+	} {
+		t.Run(s, func(t *testing.T) {
+			_, lat, err := mgrs.Inverse(s)
+			assert.NoError(t, err)
+			assertWithin(t, 1e-4, 90, math.Abs(lat))
+		})
+	}
+}
+
+func TestInverse_errors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"38SMB441408470",
+		"38SMB44140847060000000",
+		"0SMB4414084706",
+		"61SMB4414084706",
+		"38IMB4414084706",
+		"38SAB4414084706",
+		"38SMW4414084706",
+		"CAH0000000000",
+		"ZZH0000000000",
+		"ZAZ0000000000",
+	} {
+		t.Run(s, func(t *testing.T) {
+			_, _, err := mgrs.Inverse(s)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for lat := -89.5; lat < 90; lat += 7.3 {
+		for lon := -179.5; lon < 180; lon += 11.7 {
+			s, err := mgrs.Forward(lon, lat, mgrs.Precision1m)
+			assert.NoError(t, err)
+			actualLon, actualLat, err := mgrs.Inverse(s)
+			assert.NoError(t, err)
+			assertWithin(t, 1e-4, lat, actualLat)
+			assertWithin(t, 1e-4/math.Cos(lat*math.Pi/180), lon, actualLon)
+
+			roundTripS, err := mgrs.Forward(actualLon, actualLat, mgrs.Precision1m)
+			assert.NoError(t, err)
+			assert.Equal(t, s, roundTripS)
+		}
+	}
+}
+
+func assertWithin(tb testing.TB, maxDelta, expected, actual float64) {
+	tb.Helper()
+	delta := math.Abs(expected - actual)
+	if delta <= maxDelta {
+		return
+	}
+	tb.Fatalf("Expected %v to be within %v of %v, but delta is %v", actual, maxDelta, expected, delta)
+}
@@ -0,0 +1,236 @@
+package proj
+
+// #include <stdlib.h>
+// #include "go-proj.h"
+// #cgo nocallback proj_concatoperation_get_step
+// #cgo nocallback proj_concatoperation_get_step_count
+// #cgo nocallback proj_convert_conversion_to_other_method
+// #cgo nocallback proj_coordoperation_create_inverse
+// #cgo nocallback proj_coordoperation_get_method_info
+// #cgo nocallback proj_coordoperation_get_param
+// #cgo nocallback proj_coordoperation_get_param_count
+// #cgo nocallback proj_coordoperation_get_param_index
+// #cgo nocallback proj_errno_reset
+// #cgo nocallback proj_errno_restore
+// #cgo nocallback proj_get_type
+// #cgo noescape proj_concatoperation_get_step
+// #cgo noescape proj_concatoperation_get_step_count
+// #cgo noescape proj_convert_conversion_to_other_method
+// #cgo noescape proj_coordoperation_create_inverse
+// #cgo noescape proj_coordoperation_get_method_info
+// #cgo noescape proj_coordoperation_get_param
+// #cgo noescape proj_coordoperation_get_param_count
+// #cgo noescape proj_coordoperation_get_param_index
+// #cgo noescape proj_errno_reset
+// #cgo noescape proj_errno_restore
+// #cgo noescape proj_get_type
+import "C"
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// A MethodInfo describes the method of a coordinate operation.
+type MethodInfo struct {
+	Name     string
+	AuthName string
+	Code     string
+}
+
+// An OperationParam is a parameter of a coordinate operation.
+type OperationParam struct {
+	Name                 string
+	AuthName             string
+	Code                 string
+	Value                float64
+	ValueString          string
+	UnitConversionFactor float64
+	UnitName             string
+	UnitAuthName         string
+	UnitCode             string
+	UnitCategory         string
+}
+
+// ConvertToMethod returns a new conversion equivalent to pj, which must be a
+// conversion, using the method with the given EPSG code, or the given name if
+// code is zero. This is supported between the variants of Mercator and Lambert
+// Conic Conformal methods.
+func (pj *PJ) ConvertToMethod(code int, name string) (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	cName := cStringOrNil(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	return pj.context.newPJ(C.proj_convert_conversion_to_other_method(pj.context.cPJContext, pj.cPJ, C.int(code), cName))
+}
+
+// InverseOperation returns a new PJ that is the inverse of pj, which must be a
+// coordinate operation.
+func (pj *PJ) InverseOperation() (*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	return pj.context.newPJ(C.proj_coordoperation_create_inverse(pj.context.cPJContext, pj.cPJ))
+}
+
+// MethodInfo returns the methods of pj, which must be a coordinate operation.
+// If pj is a concatenated operation then the method of each of its steps is
+// returned in order, otherwise pj's method is the only one.
+func (pj *PJ) MethodInfo() ([]MethodInfo, error) {
+	steps, err := pj.Steps()
+	if err != nil {
+		return nil, err
+	}
+	defer runtime.KeepAlive(steps)
+
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	methodInfos := make([]MethodInfo, len(steps))
+	for i, step := range steps {
+		methodInfo, err := pj.context.coordOperationMethodInfo(step.cPJ)
+		if err != nil {
+			return nil, err
+		}
+		methodInfos[i] = methodInfo
+	}
+	return methodInfos, nil
+}
+
+// Param returns the parameter of pj, which must be a single coordinate
+// operation, with the given name.
+func (pj *PJ) Param(name string) (OperationParam, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+
+	index := C.proj_coordoperation_get_param_index(pj.context.cPJContext, pj.cPJ, cName)
+	if index < 0 {
+		return OperationParam{}, pj.context.lastError()
+	}
+	return pj.context.coordOperationParam(pj.cPJ, index)
+}
+
+// Params returns the parameters of pj, which must be a coordinate operation,
+// indexed by step as in Steps. If pj is a concatenated operation then the
+// parameters of each of its steps are returned in order, otherwise pj's
+// parameters are the only ones.
+func (pj *PJ) Params() ([][]OperationParam, error) {
+	steps, err := pj.Steps()
+	if err != nil {
+		return nil, err
+	}
+	defer runtime.KeepAlive(steps)
+
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	params := make([][]OperationParam, len(steps))
+	for i, step := range steps {
+		stepParams, err := pj.context.coordOperationParams(step.cPJ)
+		if err != nil {
+			return nil, err
+		}
+		params[i] = stepParams
+	}
+	return params, nil
+}
+
+// Steps returns the steps of pj, which must be a coordinate operation. If pj is
+// a concatenated operation then its steps are returned in order, otherwise pj
+// is its only step. PROJ does not expose the steps of pipelines created from
+// PROJ strings, for example "+proj=pipeline +step ...", so a pipeline is its
+// only step.
+func (pj *PJ) Steps() ([]*PJ, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	if C.proj_get_type(pj.cPJ) != C.PJ_TYPE_CONCATENATED_OPERATION {
+		return []*PJ{pj}, nil
+	}
+
+	n := int(C.proj_concatoperation_get_step_count(pj.context.cPJContext, pj.cPJ))
+	steps := make([]*PJ, n)
+	for i := range steps {
+		step, err := pj.context.newPJ(C.proj_concatoperation_get_step(pj.context.cPJContext, pj.cPJ, C.int(i)))
+		if err != nil {
+			return nil, err
+		}
+		steps[i] = step
+	}
+	return steps, nil
+}
+
+// coordOperationMethodInfo returns the method of cPJ. c must be locked.
+func (c *Context) coordOperationMethodInfo(cPJ *C.PJ) (MethodInfo, error) {
+	lastErrno := C.proj_errno_reset(cPJ)
+	defer C.proj_errno_restore(cPJ, lastErrno)
+
+	var name, authName, code *C.char
+	if C.proj_coordoperation_get_method_info(c.cPJContext, cPJ, &name, &authName, &code) == 0 {
+		return MethodInfo{}, c.lastError()
+	}
+	return MethodInfo{
+		Name:     C.GoString(name),
+		AuthName: C.GoString(authName),
+		Code:     C.GoString(code),
+	}, nil
+}
+
+// coordOperationParam returns the parameter of cPJ at index. c must be locked.
+func (c *Context) coordOperationParam(cPJ *C.PJ, index C.int) (OperationParam, error) {
+	var name, authName, code, valueString, unitName, unitAuthName, unitCode, unitCategory *C.char
+	var value, unitConversionFactor C.double
+	if C.proj_coordoperation_get_param(c.cPJContext, cPJ, index,
+		&name, &authName, &code,
+		&value, &valueString,
+		&unitConversionFactor, &unitName, &unitAuthName, &unitCode, &unitCategory,
+	) == 0 {
+		return OperationParam{}, c.lastError()
+	}
+	return OperationParam{
+		Name:                 C.GoString(name),
+		AuthName:             C.GoString(authName),
+		Code:                 C.GoString(code),
+		Value:                float64(value),
+		ValueString:          C.GoString(valueString),
+		UnitConversionFactor: float64(unitConversionFactor),
+		UnitName:             C.GoString(unitName),
+		UnitAuthName:         C.GoString(unitAuthName),
+		UnitCode:             C.GoString(unitCode),
+		UnitCategory:         C.GoString(unitCategory),
+	}, nil
+}
+
+// coordOperationParams returns all the parameters of cPJ. c must be locked.
+func (c *Context) coordOperationParams(cPJ *C.PJ) ([]OperationParam, error) {
+	lastErrno := C.proj_errno_reset(cPJ)
+	defer C.proj_errno_restore(cPJ, lastErrno)
+
+	n := int(C.proj_coordoperation_get_param_count(c.cPJContext, cPJ))
+	params := make([]OperationParam, n)
+	for i := range params {
+		param, err := c.coordOperationParam(cPJ, C.int(i))
+		if err != nil {
+			return nil, err
+		}
+		params[i] = param
+	}
+	return params, nil
+}
@@ -0,0 +1,448 @@
+package proj_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-proj/v11"
+)
+
+func TestPJ_MethodInfo(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	crs, err := context.New("EPSG:32632")
+	assert.NoError(t, err)
+
+	conversion, err := crs.CoordOperation()
+	assert.NoError(t, err)
+	assert.Equal(t, "UTM zone 32N", conversion.Name())
+
+	methodInfos, err := conversion.MethodInfo()
+	assert.NoError(t, err)
+	assert.Equal(t, []proj.MethodInfo{
+		{
+			Name:     "Transverse Mercator",
+			AuthName: "EPSG",
+			Code:     "9807",
+		},
+	}, methodInfos)
+
+	_, err = crs.MethodInfo()
+	assert.Error(t, err)
+}
+
+func TestPJ_Params(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	crs, err := context.New("EPSG:32632")
+	assert.NoError(t, err)
+
+	conversion, err := crs.CoordOperation()
+	assert.NoError(t, err)
+
+	params, err := conversion.Params()
+	assert.NoError(t, err)
+	for i, expectedParam := range []proj.OperationParam{
+		{
+			Name:                 "Latitude of natural origin",
+			AuthName:             "EPSG",
+			Code:                 "8801",
+			Value:                0,
+			UnitConversionFactor: 0.0174532925199433,
+			UnitName:             "degree",
+			UnitAuthName:         "EPSG",
+			UnitCode:             "9122",
+			UnitCategory:         "angular",
+		},
+		{
+			Name:                 "Longitude of natural origin",
+			AuthName:             "EPSG",
+			Code:                 "8802",
+			Value:                9,
+			UnitConversionFactor: 0.0174532925199433,
+			UnitName:             "degree",
+			UnitAuthName:         "EPSG",
+			UnitCode:             "9122",
+			UnitCategory:         "angular",
+		},
+		{
+			Name:                 "Scale factor at natural origin",
+			AuthName:             "EPSG",
+			Code:                 "8805",
+			Value:                0.9996,
+			UnitConversionFactor: 1,
+			UnitName:             "unity",
+			UnitAuthName:         "EPSG",
+			UnitCode:             "9201",
+			UnitCategory:         "scale",
+		},
+		{
+			Name:                 "False easting",
+			AuthName:             "EPSG",
+			Code:                 "8806",
+			Value:                500000,
+			UnitConversionFactor: 1,
+			UnitName:             "metre",
+			UnitAuthName:         "EPSG",
+			UnitCode:             "9001",
+			UnitCategory:         "linear",
+		},
+		{
+			Name:                 "False northing",
+			AuthName:             "EPSG",
+			Code:                 "8807",
+			Value:                0,
+			UnitConversionFactor: 1,
+			UnitName:             "metre",
+			UnitAuthName:         "EPSG",
+			UnitCode:             "9001",
+			UnitCategory:         "linear",
+		},
+	} {
+		actualParam := params[0][i]
+		assertInDelta(t, expectedParam.UnitConversionFactor, actualParam.UnitConversionFactor, 1e-15)
+		actualParam.UnitConversionFactor = expectedParam.UnitConversionFactor
+		assert.Equal(t, expectedParam, actualParam)
+	}
+	assert.Equal(t, 1, len(params))
+	assert.Equal(t, 5, len(params[0]))
+
+	falseEasting, err := conversion.Param("False easting")
+	assert.NoError(t, err)
+	assert.Equal(t, 500000., falseEasting.Value)
+
+	_, err = conversion.Param("invalid")
+	assert.Error(t, err)
+}
+
+func TestPJ_Params_concatenatedOperation(t *testing.T) {
+	if proj.VersionMajor < 9 || proj.VersionMajor == 9 && proj.VersionMinor < 1 {
+		t.Skip("last used operation not supported")
+	}
+
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	operation := newNTFParisToWGS84Operation(t, context)
+
+	steps, err := operation.Steps()
+	assert.NoError(t, err)
+
+	params, err := operation.Params()
+	assert.NoError(t, err)
+	assert.Equal(t, len(steps), len(params))
+
+	// The first step is the longitude rotation from the Paris meridian.
+	assert.Equal(t, 1, len(params[0]))
+	assert.Equal(t, "Longitude offset", params[0][0].Name)
+	assert.Equal(t, "8602", params[0][0].Code)
+	assert.Equal(t, 2.5969213, params[0][0].Value)
+	assert.Equal(t, "grad", params[0][0].UnitName)
+
+	for i, step := range steps {
+		stepParams, err := step.Params()
+		assert.NoError(t, err)
+		assert.Equal(t, [][]proj.OperationParam{params[i]}, stepParams)
+		if i > 0 {
+			for _, param := range params[i] {
+				assert.NotEqual(t, "8602", param.Code)
+			}
+		}
+	}
+}
+
+func TestPJ_MethodInfo_concatenatedOperation(t *testing.T) {
+	if proj.VersionMajor < 9 || proj.VersionMajor == 9 && proj.VersionMinor < 1 {
+		t.Skip("last used operation not supported")
+	}
+
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	operation := newNTFParisToWGS84Operation(t, context)
+
+	steps, err := operation.Steps()
+	assert.NoError(t, err)
+
+	methodInfos, err := operation.MethodInfo()
+	assert.NoError(t, err)
+	assert.Equal(t, len(steps), len(methodInfos))
+	assert.Equal(t, proj.MethodInfo{
+		Name:     "Longitude rotation",
+		AuthName: "EPSG",
+		Code:     "9601",
+	}, methodInfos[0])
+	for i, step := range steps {
+		stepMethodInfos, err := step.MethodInfo()
+		assert.NoError(t, err)
+		assert.Equal(t, []proj.MethodInfo{methodInfos[i]}, stepMethodInfos)
+	}
+}
+
+func TestPJ_Params_concatenatedOperationGC(t *testing.T) {
+	if proj.VersionMajor < 9 || proj.VersionMajor == 9 && proj.VersionMinor < 1 {
+		t.Skip("last used operation not supported")
+	}
+
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	operation := newNTFParisToWGS84Operation(t, context)
+
+	expectedParams, err := operation.Params()
+	assert.NoError(t, err)
+
+	// Run the garbage collector continuously so that it runs while Params is
+	// reading the parameters of each step.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				runtime.GC()
+			}
+		}
+	}()
+
+	for range 100 {
+		actualParams, err := operation.Params()
+		assert.NoError(t, err)
+		assert.Equal(t, expectedParams, actualParams)
+	}
+}
+
+// newNTFParisToWGS84Operation returns the concatenated operation used to
+// transform a point in Paris from NTF (Paris), whose prime meridian is the
+// Paris meridian, to WGS 84.
+func newNTFParisToWGS84Operation(tb testing.TB, context *proj.Context) *proj.PJ {
+	tb.Helper()
+
+	pj, err := context.NewCRSToCRS("EPSG:4807", "EPSG:4326", nil)
+	assert.NoError(tb, err)
+
+	// Paris in grads, relative to the Paris meridian.
+	_, err = pj.Forward(proj.NewCoord(54.2851, 0.0166, 0, 0))
+	assert.NoError(tb, err)
+
+	operation, err := pj.GetLastUsedOperation()
+	assert.NoError(tb, err)
+	assert.Equal(tb, proj.PJTypeConcatenatedOperation, operation.Type())
+
+	return operation
+}
+
+func TestPJ_Steps(t *testing.T) {
+	if proj.VersionMajor < 9 || proj.VersionMajor == 9 && proj.VersionMinor < 1 {
+		t.Skip("last used operation not supported")
+	}
+
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	operation := newNTFParisToWGS84Operation(t, context)
+
+	steps, err := operation.Steps()
+	assert.NoError(t, err)
+	assert.True(t, len(steps) >= 2)
+
+	methodInfos, err := steps[0].MethodInfo()
+	assert.NoError(t, err)
+	assert.Equal(t, []proj.MethodInfo{
+		{
+			Name:     "Longitude rotation",
+			AuthName: "EPSG",
+			Code:     "9601",
+		},
+	}, methodInfos)
+
+	sourceCRS, err := steps[0].SourceCRS()
+	assert.NoError(t, err)
+	assert.Equal(t, "NTF (Paris)", sourceCRS.Name())
+}
+
+func TestPJ_Steps_singleOperation(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	crs, err := context.New("EPSG:32632")
+	assert.NoError(t, err)
+
+	conversion, err := crs.CoordOperation()
+	assert.NoError(t, err)
+
+	steps, err := conversion.Steps()
+	assert.NoError(t, err)
+	assert.Equal(t, []*proj.PJ{conversion}, steps)
+}
+
+func TestPJ_Steps_lastUsedOperation(t *testing.T) {
+	if proj.VersionMajor < 9 || proj.VersionMajor == 9 && proj.VersionMinor < 1 {
+		t.Skip("last used operation not supported")
+	}
+
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	pj, err := context.NewCRSToCRS("EPSG:4326", "EPSG:3857", nil)
+	assert.NoError(t, err)
+
+	_, err = pj.Forward(newYorkEPSG4326)
+	assert.NoError(t, err)
+
+	operation, err := pj.GetLastUsedOperation()
+	assert.NoError(t, err)
+	assert.Equal(t, proj.PJTypeConversion, operation.Type())
+
+	steps, err := operation.Steps()
+	assert.NoError(t, err)
+	assert.Equal(t, []*proj.PJ{operation}, steps)
+
+	methodInfos, err := operation.MethodInfo()
+	assert.NoError(t, err)
+	assert.Equal(t, []proj.MethodInfo{
+		{
+			Name:     "Popular Visualisation Pseudo Mercator",
+			AuthName: "EPSG",
+			Code:     "1024",
+		},
+	}, methodInfos)
+}
+
+func TestPJ_Steps_pipeline(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	pipeline, err := context.New("+proj=pipeline +step +proj=axisswap +order=2,1 +step +proj=unitconvert +xy_in=deg +xy_out=rad +step +proj=merc +ellps=WGS84")
+	assert.NoError(t, err)
+
+	steps, err := pipeline.Steps()
+	assert.NoError(t, err)
+	assert.Equal(t, []*proj.PJ{pipeline}, steps)
+}
+
+func TestPJ_InverseOperation(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	pj, err := context.NewCRSToCRS("EPSG:4326", "EPSG:3857", nil)
+	assert.NoError(t, err)
+
+	inverse, err := pj.InverseOperation()
+	assert.NoError(t, err)
+
+	sourceCRS, err := inverse.SourceCRS()
+	assert.NoError(t, err)
+	assert.Equal(t, "WGS 84 / Pseudo-Mercator", sourceCRS.Name())
+
+	targetCRS, err := inverse.TargetCRS()
+	assert.NoError(t, err)
+	assert.Equal(t, "WGS 84", targetCRS.Name())
+
+	actualCoord, err := inverse.Forward(newYorkEPSG3857)
+	assert.NoError(t, err)
+	assertInDeltaFloat64Slice(t, newYorkEPSG4326[:], actualCoord[:], 1e-6)
+}
+
+func TestPJ_ConvertToMethod(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	for _, tc := range []struct {
+		definition         string
+		code               int
+		name               string
+		coord              proj.Coord
+		expectedMethodInfo proj.MethodInfo
+	}{
+		{
+			definition: "EPSG:3395",
+			code:       9805,
+			coord:      proj.NewCoord(45, 10, 0, 0),
+			expectedMethodInfo: proj.MethodInfo{
+				Name:     "Mercator (variant B)",
+				AuthName: "EPSG",
+				Code:     "9805",
+			},
+		},
+		{
+			definition: "EPSG:2154",
+			name:       "Lambert Conic Conformal (1SP)",
+			coord:      proj.NewCoord(46.5, 3, 0, 0),
+			expectedMethodInfo: proj.MethodInfo{
+				Name:     "Lambert Conic Conformal (1SP)",
+				AuthName: "EPSG",
+				Code:     "9801",
+			},
+		},
+	} {
+		t.Run(tc.definition, func(t *testing.T) {
+			crs, err := context.New(tc.definition)
+			assert.NoError(t, err)
+
+			conversion, err := crs.CoordOperation()
+			assert.NoError(t, err)
+
+			convertedConversion, err := conversion.ConvertToMethod(tc.code, tc.name)
+			assert.NoError(t, err)
+
+			methodInfos, err := convertedConversion.MethodInfo()
+			assert.NoError(t, err)
+			assert.Equal(t, []proj.MethodInfo{tc.expectedMethodInfo}, methodInfos)
+
+			baseCRS, err := crs.SourceCRS()
+			assert.NoError(t, err)
+
+			convertedCRS, err := context.NewProjectedCRS(crs.Name(), baseCRS, convertedConversion, nil)
+			assert.NoError(t, err)
+
+			pj, err := context.NewCRSToCRSFromPJ(baseCRS, crs, nil, "")
+			assert.NoError(t, err)
+			expectedCoord, err := pj.Forward(tc.coord)
+			assert.NoError(t, err)
+
+			convertedPJ, err := context.NewCRSToCRSFromPJ(baseCRS, convertedCRS, nil, "")
+			assert.NoError(t, err)
+			actualCoord, err := convertedPJ.Forward(tc.coord)
+			assert.NoError(t, err)
+			assertInDeltaFloat64Slice(t, expectedCoord[:], actualCoord[:], 1e-3)
+		})
+	}
+
+	crs, err := context.New("EPSG:32632")
+	assert.NoError(t, err)
+
+	conversion, err := crs.CoordOperation()
+	assert.NoError(t, err)
+
+	_, err = conversion.ConvertToMethod(9801, "")
+	assert.Error(t, err)
+}
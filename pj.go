@@ -1,11 +1,15 @@
 package proj
 
+// #include <stdlib.h>
 // #include "go-proj.h"
+// #cgo nocallback proj_as_wkt
 // #cgo nocallback proj_errno
 // #cgo nocallback proj_errno_reset
 // #cgo nocallback proj_errno_restore
 // #cgo nocallback proj_geod
+// #cgo nocallback proj_get_type
 // #cgo nocallback proj_is_crs
+// #cgo nocallback proj_is_equivalent_to_with_ctx
 // #cgo nocallback proj_lp_dist
 // #cgo nocallback proj_lpz_dist
 // #cgo nocallback proj_normalize_for_visualization
@@ -15,11 +19,14 @@ package proj
 // #cgo nocallback proj_trans_bounds
 // #cgo nocallback proj_trans_generic
 // #cgo nocallback proj_trans_get_last_used_operation
+// #cgo noescape proj_as_wkt
 // #cgo noescape proj_errno
 // #cgo noescape proj_errno_reset
 // #cgo noescape proj_errno_restore
 // #cgo noescape proj_geod
+// #cgo noescape proj_get_type
 // #cgo noescape proj_is_crs
+// #cgo noescape proj_is_equivalent_to_with_ctx
 // #cgo noescape proj_lp_dist
 // #cgo noescape proj_lpz_dist
 // #cgo noescape proj_normalize_for_visualization
@@ -35,6 +42,16 @@ import (
 	"unsafe"
 )
 
+// A Comparison is a criterion for comparing PJs.
+type Comparison C.PJ_COMPARISON_CRITERION
+
+// Comparisons.
+const (
+	ComparisonStrict                           Comparison = C.PJ_COMP_STRICT
+	ComparisonEquivalent                       Comparison = C.PJ_COMP_EQUIVALENT
+	ComparisonEquivalentExceptAxisOrderGeogCRS Comparison = C.PJ_COMP_EQUIVALENT_EXCEPT_AXIS_ORDER_GEOGCRS
+)
+
 // A Direction is a direction.
 type Direction C.PJ_DIRECTION
 
@@ -45,6 +62,48 @@ const (
 	DirectionInv   Direction = C.PJ_INV
 )
 
+// A PJType is the type of a PJ.
+type PJType C.PJ_TYPE
+
+// PJ types.
+const (
+	PJTypeUnknown                  PJType = C.PJ_TYPE_UNKNOWN
+	PJTypeEllipsoid                PJType = C.PJ_TYPE_ELLIPSOID
+	PJTypePrimeMeridian            PJType = C.PJ_TYPE_PRIME_MERIDIAN
+	PJTypeGeodeticReferenceFrame   PJType = C.PJ_TYPE_GEODETIC_REFERENCE_FRAME
+	PJTypeVerticalReferenceFrame   PJType = C.PJ_TYPE_VERTICAL_REFERENCE_FRAME
+	PJTypeCRS                      PJType = C.PJ_TYPE_CRS
+	PJTypeGeodeticCRS              PJType = C.PJ_TYPE_GEODETIC_CRS
+	PJTypeGeocentricCRS            PJType = C.PJ_TYPE_GEOCENTRIC_CRS
+	PJTypeGeographicCRS            PJType = C.PJ_TYPE_GEOGRAPHIC_CRS
+	PJTypeGeographic2DCRS          PJType = C.PJ_TYPE_GEOGRAPHIC_2D_CRS
+	PJTypeGeographic3DCRS          PJType = C.PJ_TYPE_GEOGRAPHIC_3D_CRS
+	PJTypeVerticalCRS              PJType = C.PJ_TYPE_VERTICAL_CRS
+	PJTypeProjectedCRS             PJType = C.PJ_TYPE_PROJECTED_CRS
+	PJTypeCompoundCRS              PJType = C.PJ_TYPE_COMPOUND_CRS
+	PJTypeTemporalCRS              PJType = C.PJ_TYPE_TEMPORAL_CRS
+	PJTypeEngineeringCRS           PJType = C.PJ_TYPE_ENGINEERING_CRS
+	PJTypeBoundCRS                 PJType = C.PJ_TYPE_BOUND_CRS
+	PJTypeOtherCRS                 PJType = C.PJ_TYPE_OTHER_CRS
+	PJTypeConversion               PJType = C.PJ_TYPE_CONVERSION
+	PJTypeTransformation           PJType = C.PJ_TYPE_TRANSFORMATION
+	PJTypeConcatenatedOperation    PJType = C.PJ_TYPE_CONCATENATED_OPERATION
+	PJTypeOtherCoordinateOperation PJType = C.PJ_TYPE_OTHER_COORDINATE_OPERATION
+)
+
+// A WKTType is a WKT version.
+type WKTType C.PJ_WKT_TYPE
+
+// WKT types.
+const (
+	WKTType2015           WKTType = C.PJ_WKT2_2015
+	WKTType2015Simplified WKTType = C.PJ_WKT2_2015_SIMPLIFIED
+	WKTType2019           WKTType = C.PJ_WKT2_2019
+	WKTType2019Simplified WKTType = C.PJ_WKT2_2019_SIMPLIFIED
+	WKTType1GDAL          WKTType = C.PJ_WKT1_GDAL
+	WKTType1ESRI          WKTType = C.PJ_WKT1_ESRI
+)
+
 // A PJ is a projection or a transformation.
 type PJ struct {
 	context *Context
@@ -60,6 +119,28 @@ type PJInfo struct {
 	Accuracy    float64
 }
 
+// AsWKT returns the WKT representation of pj. options are PROJ WKT export
+// options, for example "MULTILINE=NO".
+func (pj *PJ) AsWKT(wktType WKTType, options ...string) (string, error) {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+
+	lastErrno := C.proj_errno_reset(pj.cPJ)
+	defer C.proj_errno_restore(pj.cPJ, lastErrno)
+
+	cOptions := make([]*C.char, len(options)+1)
+	for i, option := range options {
+		cOptions[i] = C.CString(option)
+		defer C.free(unsafe.Pointer(cOptions[i]))
+	}
+
+	cWKT := C.proj_as_wkt(pj.context.cPJContext, pj.cPJ, C.PJ_WKT_TYPE(wktType), &cOptions[0])
+	if cWKT == nil {
+		return "", pj.context.lastError()
+	}
+	return C.GoString(cWKT), nil
+}
+
 // NormalizeForVisualization returns a new PJ instance whose axis order is the
 // one expected for visualization purposes. If the axis order of its source or
 // target CRS is northing, easting, then an axis swap operation will be
@@ -139,6 +220,16 @@ func (pj *PJ) IsCRS() bool {
 	return C.proj_is_crs(pj.cPJ) != 0
 }
 
+// IsEquivalentTo returns whether pj is equivalent to other according to
+// criterion. pj and other may belong to different Contexts.
+func (pj *PJ) IsEquivalentTo(other *PJ, criterion Comparison) bool {
+	pj.context.Lock()
+	defer pj.context.Unlock()
+	defer pj.context.lockPJContexts(other)()
+
+	return C.proj_is_equivalent_to_with_ctx(pj.context.cPJContext, pj.cPJ, other.cPJ, C.PJ_COMPARISON_CRITERION(criterion)) != 0
+}
+
 // Inverse transforms coord in the inverse direction.
 func (pj *PJ) Inverse(coord Coord) (Coord, error) {
 	return pj.Trans(DirectionInv, coord)
@@ -308,3 +399,8 @@ func (pj *PJ) TransGeneric(direction Direction, x *float64, sx, nx int, y *float
 
 	return nil
 }
+
+// Type returns pj's type.
+func (pj *PJ) Type() PJType {
+	return PJType(C.proj_get_type(pj.cPJ))
+}
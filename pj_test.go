@@ -5,6 +5,7 @@ import (
 	"runtime"
 	"slices"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/alecthomas/assert/v2"
@@ -624,3 +625,115 @@ func assertInDeltaFloat64Slices(tb testing.TB, expected, actual [][]float64, del
 		assertInDeltaFloat64Slice(tb, expected[i], actual[i], delta)
 	}
 }
+
+func TestPJ_IsEquivalentTo(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	otherContext := proj.NewContext()
+	assert.NotZero(t, otherContext)
+
+	epsg4326, err := context.New("EPSG:4326")
+	assert.NoError(t, err)
+
+	otherEPSG4326, err := otherContext.New("EPSG:4326")
+	assert.NoError(t, err)
+
+	lonLatWGS84, err := otherContext.New("+proj=longlat +datum=WGS84 +no_defs +type=crs")
+	assert.NoError(t, err)
+
+	epsg2056, err := context.New("EPSG:2056")
+	assert.NoError(t, err)
+
+	for _, tc := range []struct {
+		name      string
+		a         *proj.PJ
+		b         *proj.PJ
+		criterion proj.Comparison
+		expected  bool
+	}{
+		{
+			name:      "same_strict",
+			a:         epsg4326,
+			b:         otherEPSG4326,
+			criterion: proj.ComparisonStrict,
+			expected:  true,
+		},
+		{
+			name:      "axis_order_equivalent",
+			a:         epsg4326,
+			b:         lonLatWGS84,
+			criterion: proj.ComparisonEquivalent,
+			expected:  false,
+		},
+		{
+			name:      "axis_order_equivalent_except_axis_order",
+			a:         epsg4326,
+			b:         lonLatWGS84,
+			criterion: proj.ComparisonEquivalentExceptAxisOrderGeogCRS,
+			expected:  true,
+		},
+		{
+			name:      "different",
+			a:         epsg4326,
+			b:         epsg2056,
+			criterion: proj.ComparisonEquivalentExceptAxisOrderGeogCRS,
+			expected:  false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.a.IsEquivalentTo(tc.b, tc.criterion))
+			assert.Equal(t, tc.expected, tc.b.IsEquivalentTo(tc.a, tc.criterion))
+		})
+	}
+}
+
+func TestPJ_AsWKT(t *testing.T) {
+	defer runtime.GC()
+
+	context := proj.NewContext()
+	assert.NotZero(t, context)
+
+	crs, err := context.New("EPSG:4326")
+	assert.NoError(t, err)
+
+	for _, tc := range []struct {
+		name           string
+		wktType        proj.WKTType
+		expectedPrefix string
+	}{
+		{
+			name:           "wkt2_2015",
+			wktType:        proj.WKTType2015,
+			expectedPrefix: `GEODCRS["WGS 84",`,
+		},
+		{
+			name:           "wkt2_2019",
+			wktType:        proj.WKTType2019,
+			expectedPrefix: `GEOGCRS["WGS 84",`,
+		},
+		{
+			name:           "wkt1_gdal",
+			wktType:        proj.WKTType1GDAL,
+			expectedPrefix: `GEOGCS["WGS 84",`,
+		},
+		{
+			name:           "wkt1_esri",
+			wktType:        proj.WKTType1ESRI,
+			expectedPrefix: `GEOGCS["GCS_WGS_1984",`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			wkt, err := crs.AsWKT(tc.wktType, "MULTILINE=NO")
+			assert.NoError(t, err)
+			assert.True(t, strings.HasPrefix(wkt, tc.expectedPrefix))
+			assert.False(t, strings.Contains(wkt, "\n"))
+		})
+	}
+
+	multilineWKT, err := crs.AsWKT(proj.WKTType2019)
+	assert.NoError(t, err)
+	assert.True(t, strings.Contains(multilineWKT, "\n"))
+}
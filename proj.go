@@ -12,6 +12,7 @@ package proj
 import "C"
 
 import (
+	"errors"
 	"math"
 	"runtime"
 )
@@ -23,6 +24,23 @@ const (
 	VersionPatch = C.PROJ_VERSION_PATCH
 )
 
+// ErrAlreadyWGS84 is returned when a CRS cannot be bound to WGS 84 because it
+// is already based on WGS 84.
+var ErrAlreadyWGS84 = errors.New("already WGS 84")
+
+// ErrAmbiguous is returned when PROJ returns several candidate objects where a
+// single object is expected.
+var ErrAmbiguous = errors.New("ambiguous")
+
+// ErrNoHelmertTransformation is returned when a CRS cannot be bound to WGS 84
+// with a Helmert transformation.
+var ErrNoHelmertTransformation = errors.New("no Helmert transformation")
+
+// ErrNotFound is returned when PROJ does not return a requested object or value
+// but does not report an error, for example when requesting the datum of a CRS
+// that has a datum ensemble.
+var ErrNotFound = errors.New("not found")
+
 // An Area is an area.
 type Area struct {
 	cPJArea *C.PJ_AREA
@@ -89,3 +107,12 @@ func (c *Coord) M() float64 { return c[3] }
 func (e *Error) Error() string {
 	return e.context.errnoString(e.errno)
 }
+
+// cStringOrNil returns s as a C string, or nil if s is empty. The caller must
+// free the result.
+func cStringOrNil(s string) *C.char {
+	if s == "" {
+		return nil
+	}
+	return C.CString(s)
+}
@@ -0,0 +1,83 @@
+// Package ups converts between longitude and latitude and Universal Polar
+// Stereographic (UPS) coordinates, which cover the polar regions outside UTM.
+package ups
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/twpayne/go-proj/v11"
+	"github.com/twpayne/go-proj/v11/utm"
+)
+
+// A Coord is a UPS coordinate.
+type Coord struct {
+	Hemisphere utm.Hemisphere
+	E          float64
+	N          float64
+}
+
+var transformationCache sync.Map
+
+// LonLat returns the longitude and latitude of c.
+func (c Coord) LonLat() (float64, float64, error) {
+	return Inverse(c)
+}
+
+func (c Coord) String() string {
+	return fmt.Sprintf("%s %d %d", c.Hemisphere, int(c.E+0.5), int(c.N+0.5))
+}
+
+// Forward returns the forward transformation of (lon, lat) to UPS. The
+// hemisphere is north if lat is non-negative and south otherwise.
+func Forward(lon, lat float64) (Coord, error) {
+	hemisphere := utm.HemisphereNorth
+	if lat < 0 {
+		hemisphere = utm.HemisphereSouth
+	}
+	pj, err := Transformation(hemisphere)
+	if err != nil {
+		return Coord{}, err
+	}
+	upsCoord, err := pj.Forward(proj.NewCoord(lat, lon, 0, 0))
+	if err != nil {
+		return Coord{}, err
+	}
+	// EPSG:32661 and EPSG:32761 have northing, easting axis order.
+	return Coord{
+		Hemisphere: hemisphere,
+		E:          upsCoord.Y(),
+		N:          upsCoord.X(),
+	}, nil
+}
+
+// Inverse returns the inverse transformation of c to longitude and latitude.
+func Inverse(c Coord) (float64, float64, error) {
+	pj, err := Transformation(c.Hemisphere)
+	if err != nil {
+		return 0, 0, err
+	}
+	lonLatCoord, err := pj.Inverse(proj.NewCoord(c.N, c.E, 0, 0))
+	if err != nil {
+		return 0, 0, err
+	}
+	return lonLatCoord.Y(), lonLatCoord.X(), nil
+}
+
+// Transformation returns the transformation from EPSG:4326 to UPS in
+// hemisphere, either EPSG:32661 or EPSG:32761.
+func Transformation(hemisphere utm.Hemisphere) (*proj.PJ, error) {
+	if pj, ok := transformationCache.Load(hemisphere); ok {
+		return pj.(*proj.PJ), nil //nolint:forcetypeassert
+	}
+	targetCRS := "epsg:32661"
+	if hemisphere == utm.HemisphereSouth {
+		targetCRS = "epsg:32761"
+	}
+	pj, err := proj.NewCRSToCRS("epsg:4326", targetCRS, nil)
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := transformationCache.LoadOrStore(hemisphere, pj)
+	return actual.(*proj.PJ), nil //nolint:forcetypeassert
+}
@@ -0,0 +1,96 @@
+package ups_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-proj/v11/ups"
+	"github.com/twpayne/go-proj/v11/utm"
+)
+
+func TestForwardInverse(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		lon, lat float64
+		expected ups.Coord
+	}{
+		{
+			name: "North Pole",
+			lon:  0,
+			lat:  90,
+			expected: ups.Coord{
+				E: 2_000_000,
+				N: 2_000_000,
+			},
+		},
+		{
+			name: "South Pole",
+			lon:  0,
+			lat:  -90,
+			expected: ups.Coord{
+				Hemisphere: utm.HemisphereSouth,
+				E:          2_000_000,
+				N:          2_000_000,
+			},
+		},
+		{
+			name: "North west",
+			lon:  -45,
+			lat:  85,
+			expected: ups.Coord{
+				E: 1_607_232.3119,
+				N: 1_607_232.3119,
+			},
+		},
+		{
+			name: "North east",
+			lon:  45,
+			lat:  85,
+			expected: ups.Coord{
+				E: 2_392_767.6881,
+				N: 1_607_232.3119,
+			},
+		},
+		{
+			name: "South west",
+			lon:  -45,
+			lat:  -85,
+			expected: ups.Coord{
+				Hemisphere: utm.HemisphereSouth,
+				E:          1_607_232.3119,
+				N:          2_392_767.6881,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := ups.Forward(tc.lon, tc.lat)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected.Hemisphere, actual.Hemisphere)
+			assertWithin(t, 1e-3, tc.expected.E, actual.E)
+			assertWithin(t, 1e-3, tc.expected.N, actual.N)
+
+			lon, lat, err := actual.LonLat()
+			assert.NoError(t, err)
+			assertWithin(t, 1e-9, tc.lat, lat)
+			if math.Abs(tc.lat) != 90 {
+				assertWithin(t, 1e-9, tc.lon, lon)
+			}
+		})
+	}
+}
+
+func TestCoord_String(t *testing.T) {
+	assert.Equal(t, "N 2000000 2000000", ups.Coord{E: 2_000_000, N: 2_000_000}.String())
+	assert.Equal(t, "S 1607232 2392768", ups.Coord{Hemisphere: utm.HemisphereSouth, E: 1_607_232.3119, N: 2_392_767.6881}.String())
+}
+
+func assertWithin(tb testing.TB, maxDelta, expected, actual float64) {
+	tb.Helper()
+	delta := math.Abs(expected - actual)
+	if delta <= maxDelta {
+		return
+	}
+	tb.Fatalf("Expected %v to be within %v of %v, but delta is %v", actual, maxDelta, expected, delta)
+}
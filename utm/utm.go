@@ -3,52 +3,476 @@ package utm
 import (
 	"errors"
 	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/twpayne/go-proj/v11"
 )
 
-// A Coord is a UTM coordinate.
+// A Datum is a datum on which UTM coordinates can be based.
+type Datum int
+
+// Datums.
+const (
+	DatumWGS84 Datum = iota
+	DatumNAD83
+	DatumETRS89
+)
+
+// A Hemisphere is a hemisphere.
+type Hemisphere int
+
+// Hemispheres.
+const (
+	HemisphereNorth Hemisphere = iota
+	HemisphereSouth
+)
+
+// A Coord is a UTM coordinate. N is the northing relative to the false northing
+// of Hemisphere, and so is always non-negative.
 type Coord struct {
-	Zone int
-	E    float64
-	N    float64
+	Zone       int
+	Hemisphere Hemisphere
+	E          float64
+	N          float64
+}
+
+// A Distortion describes the distortion at a coordinate transformed to a UTM
+// zone. Outside is the distance in degrees of longitude outside the zone's
+// nominal 6° strip, or zero if the coordinate is inside the strip. ScaleError
+// is the point scale factor minus one.
+type Distortion struct {
+	Outside    float64
+	ScaleError float64
+}
+
+// A GridZone is a UTM grid zone, the intersection of a zone and a latitude
+// band. Bounds are in longitude and latitude.
+type GridZone struct {
+	Designator string
+	Zone       int
+	Band       byte
+	Bounds     proj.Bounds
+}
+
+// A PolarError is returned when a coordinate is in a polar region outside UTM.
+// Use the ups package for such coordinates.
+type PolarError struct {
+	Lon float64
+	Lat float64
+}
+
+// A zoneHemisphere is a UTM zone and hemisphere.
+type zoneHemisphere struct {
+	zone       int
+	hemisphere Hemisphere
+}
+
+// A transformationCacheKey is a key in transformationCache.
+type transformationCacheKey struct {
+	datum Datum
+	zone  int
+	south bool
 }
 
 var (
-	errUndefined = errors.New("undefined")
+	errOutOfRange = errors.New("out of range")
+	errSyntax     = errors.New("syntax error")
+	errUndefined  = errors.New("undefined")
+
+	// wgs84SemiMajorAxis and wgs84EccentricitySquared are the semi-major axis,
+	// in meters, and the square of the eccentricity of the WGS84 ellipsoid.
+	wgs84SemiMajorAxis       = 6378137.0
+	wgs84EccentricitySquared = 0.0066943799901413165
+
+	bandLetters = "CDEFGHJKLMNPQRSTUVWX"
+
+	coordRegexps = []*regexp.Regexp{
+		regexp.MustCompile(`\A\s*(\d{1,2})\s*([A-Za-z])\s+(\d+(?:\.\d*)?)\s+(\d+(?:\.\d*)?)\s*\z`),
+		regexp.MustCompile(`(?i)\A\s*zone\s*(\d{1,2})\s*([a-z])\s*,?\s*E\s*(\d+(?:\.\d*)?)\s*,?\s*N\s*(\d+(?:\.\d*)?)\s*\z`),
+	}
 
+	axisOrderCache      sync.Map
+	projectionCache     sync.Map
 	transformationCache sync.Map
 )
 
+func (d Datum) String() string {
+	switch d {
+	case DatumWGS84:
+		return "WGS84"
+	case DatumNAD83:
+		return "NAD83"
+	case DatumETRS89:
+		return "ETRS89"
+	default:
+		return "Datum(" + strconv.Itoa(int(d)) + ")"
+	}
+}
+
+// geographicEPSGCode returns the EPSG code of d's geographic CRS, or -1 if d is
+// unknown.
+func (d Datum) geographicEPSGCode() int {
+	switch d {
+	case DatumWGS84:
+		return 4326
+	case DatumNAD83:
+		return 4269
+	case DatumETRS89:
+		return 4258
+	default:
+		return -1
+	}
+}
+
+func (e *PolarError) Error() string {
+	return fmt.Sprintf("%f, %f: outside UTM, use UPS", e.Lon, e.Lat)
+}
+
+func (h Hemisphere) String() string {
+	if h == HemisphereSouth {
+		return "S"
+	}
+	return "N"
+}
+
+// LonLat returns the longitude and latitude of c.
+func (c Coord) LonLat() (float64, float64, error) {
+	return Inverse(c)
+}
+
+// BandString returns c as a string with the latitude band letter instead of the
+// hemisphere, for example "33U 500000 5300000". Use ParseBand to parse it.
+func (c Coord) BandString() (string, error) {
+	_, lat, err := c.LonLat()
+	if err != nil {
+		return "", err
+	}
+	band, err := Band(lat)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d%c %d %d", c.Zone, band, int(c.E+0.5), int(c.N+0.5)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c Coord) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
 func (c Coord) String() string {
-	if c.N >= 0 {
-		return fmt.Sprintf("%dN %d %d", c.Zone, int(c.E+0.5), int(c.N+0.5))
+	return fmt.Sprintf("%d%s %d %d", c.Zone, c.Hemisphere, int(c.E+0.5), int(c.N+0.5))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *Coord) UnmarshalText(text []byte) error {
+	coord, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*c = coord
+	return nil
+}
+
+// Band returns the latitude band letter at lat.
+func Band(lat float64) (byte, error) {
+	if lat < -80 || 84 <= lat {
+		return 0, errUndefined
+	}
+	return bandLetters[min(int((lat+80)/8), len(bandLetters)-1)], nil
+}
+
+// Designator returns the UTM grid zone designator, for example "33U", at lon
+// and lat, or the empty string if there is no grid zone at that coordinate.
+func Designator(lon, lat float64) string {
+	zone := Zone(lon, lat)
+	if zone < 0 {
+		return ""
+	}
+	band, err := Band(lat)
+	if err != nil {
+		return ""
 	}
-	return fmt.Sprintf("%dS %d %d", c.Zone, int(c.E+0.5), int(c.N-0.5)+10_000_000)
+	return strconv.Itoa(zone) + string(band)
 }
 
-// Forward returns the forward transformation of (lon, lat) to UTM.
+// EPSGCode returns the EPSG code of the projected CRS for zone and hemisphere
+// on datum, or -1 if there is no such CRS. WGS84 covers zones 1 to 60 in both
+// hemispheres, NAD83 covers zones 1 to 23 in the northern hemisphere, and
+// ETRS89 covers zones 28 to 38 in the northern hemisphere.
+func EPSGCode(zone int, south bool, datum Datum) int {
+	switch {
+	case datum == DatumWGS84 && 1 <= zone && zone <= 60 && !south:
+		return 32600 + zone
+	case datum == DatumWGS84 && 1 <= zone && zone <= 60 && south:
+		return 32700 + zone
+	case datum == DatumNAD83 && 1 <= zone && zone <= 23 && !south:
+		return 26900 + zone
+	case datum == DatumETRS89 && 28 <= zone && zone <= 38 && !south:
+		return 25800 + zone
+	default:
+		return -1
+	}
+}
+
+// Forward returns the forward transformation of (lon, lat) to UTM. It returns a
+// *PolarError if (lon, lat) is in a polar region outside UTM.
 func Forward(lon, lat float64) (Coord, error) {
 	zone := Zone(lon, lat)
 	if zone < 0 {
-		return Coord{}, errUndefined
+		return Coord{}, &PolarError{Lon: lon, Lat: lat}
+	}
+	hemisphere := HemisphereNorth
+	if lat < 0 {
+		hemisphere = HemisphereSouth
+	}
+	pj, err := ZoneHemisphereTransformation(zone, hemisphere)
+	if err != nil {
+		return Coord{}, err
 	}
-	pj, err := ZoneTransformation(zone)
+	axisOrder, err := geographicAxisOrder(DatumWGS84)
 	if err != nil {
 		return Coord{}, err
 	}
-	utmCoord, err := pj.Forward(proj.NewCoord(lat, lon, 0, 0))
+	utmCoord, err := pj.Forward(axisOrder.FromLonLat(proj.NewCoord(lon, lat, 0, 0)))
 	if err != nil {
 		return Coord{}, err
 	}
 	return Coord{
-		Zone: zone,
-		E:    utmCoord.X(),
-		N:    utmCoord.Y(),
+		Zone:       zone,
+		Hemisphere: hemisphere,
+		E:          utmCoord.X(),
+		N:          utmCoord.Y(),
 	}, nil
 }
 
+// ForwardArray returns the forward transformation of lonLats, whose first two
+// elements are longitude and latitude, to UTM. The coordinates are transformed
+// with one call to PROJ per zone and hemisphere. It returns a *PolarError if
+// any coordinate is in a polar region outside UTM.
+func ForwardArray(lonLats []proj.Coord) ([]Coord, error) {
+	var keys []zoneHemisphere
+	indexes := make(map[zoneHemisphere][]int)
+	coords := make(map[zoneHemisphere][]proj.Coord)
+	for i, lonLat := range lonLats {
+		lon, lat := lonLat[0], lonLat[1]
+		zone := Zone(lon, lat)
+		if zone < 0 {
+			return nil, &PolarError{Lon: lon, Lat: lat}
+		}
+		key := zoneHemisphere{
+			zone:       zone,
+			hemisphere: HemisphereNorth,
+		}
+		if lat < 0 {
+			key.hemisphere = HemisphereSouth
+		}
+		if _, ok := indexes[key]; !ok {
+			keys = append(keys, key)
+		}
+		indexes[key] = append(indexes[key], i)
+		coords[key] = append(coords[key], proj.NewCoord(lon, lat, 0, 0))
+	}
+
+	axisOrder, err := geographicAxisOrder(DatumWGS84)
+	if err != nil {
+		return nil, err
+	}
+	utmCoords := make([]Coord, len(lonLats))
+	for _, key := range keys {
+		pj, err := ZoneHemisphereTransformation(key.zone, key.hemisphere)
+		if err != nil {
+			return nil, err
+		}
+		axisOrder.FromLonLatArray(coords[key])
+		if err := pj.ForwardArray(coords[key]); err != nil {
+			return nil, err
+		}
+		for j, i := range indexes[key] {
+			utmCoords[i] = Coord{
+				Zone:       key.zone,
+				Hemisphere: key.hemisphere,
+				E:          coords[key][j].X(),
+				N:          coords[key][j].Y(),
+			}
+		}
+	}
+	return utmCoords, nil
+}
+
+// ForwardArrayInZone returns the forward transformation of lonLats, whose
+// first two elements are longitude and latitude, to the given UTM zone and
+// hemisphere, and the distortion at each coordinate. Coordinates may be outside
+// the zone's nominal 6° strip and, if they are in the other hemisphere, have
+// negative northings.
+func ForwardArrayInZone(lonLats []proj.Coord, zone int, hemisphere Hemisphere) ([]Coord, []Distortion, error) {
+	pj, err := ZoneHemisphereTransformation(zone, hemisphere)
+	if err != nil {
+		return nil, nil, err
+	}
+	axisOrder, err := geographicAxisOrder(DatumWGS84)
+	if err != nil {
+		return nil, nil, err
+	}
+	coords := make([]proj.Coord, len(lonLats))
+	distortions := make([]Distortion, len(lonLats))
+	for i, lonLat := range lonLats {
+		coords[i] = proj.NewCoord(lonLat[0], lonLat[1], 0, 0)
+		distortions[i], err = zoneDistortion(lonLat[0], lonLat[1], zone)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	axisOrder.FromLonLatArray(coords)
+	if err := pj.ForwardArray(coords); err != nil {
+		return nil, nil, err
+	}
+	utmCoords := make([]Coord, len(lonLats))
+	for i, coord := range coords {
+		utmCoords[i] = Coord{
+			Zone:       zone,
+			Hemisphere: hemisphere,
+			E:          coord.X(),
+			N:          coord.Y(),
+		}
+	}
+	return utmCoords, distortions, nil
+}
+
+// ForwardInZone returns the forward transformation of (lon, lat) to the given
+// UTM zone and hemisphere, and the distortion at (lon, lat). (lon, lat) may be
+// outside the zone's nominal 6° strip and, if it is in the other hemisphere,
+// have a negative northing.
+func ForwardInZone(lon, lat float64, zone int, hemisphere Hemisphere) (Coord, Distortion, error) {
+	pj, err := ZoneHemisphereTransformation(zone, hemisphere)
+	if err != nil {
+		return Coord{}, Distortion{}, err
+	}
+	axisOrder, err := geographicAxisOrder(DatumWGS84)
+	if err != nil {
+		return Coord{}, Distortion{}, err
+	}
+	utmCoord, err := pj.Forward(axisOrder.FromLonLat(proj.NewCoord(lon, lat, 0, 0)))
+	if err != nil {
+		return Coord{}, Distortion{}, err
+	}
+	distortion, err := zoneDistortion(lon, lat, zone)
+	if err != nil {
+		return Coord{}, Distortion{}, err
+	}
+	return Coord{
+		Zone:       zone,
+		Hemisphere: hemisphere,
+		E:          utmCoord.X(),
+		N:          utmCoord.Y(),
+	}, distortion, nil
+}
+
+// GridZones returns every UTM grid zone, ordered by zone and then by band.
+func GridZones() []GridZone {
+	var gridZones []GridZone
+	for zone := 1; zone <= 60; zone++ {
+		for bandIndex := range len(bandLetters) {
+			bounds, ok := gridZoneBounds(zone, bandIndex)
+			if !ok {
+				continue
+			}
+			gridZone := GridZone{
+				Designator: strconv.Itoa(zone) + string(bandLetters[bandIndex]),
+				Zone:       zone,
+				Band:       bandLetters[bandIndex],
+				Bounds:     bounds,
+			}
+			gridZones = append(gridZones, gridZone)
+		}
+	}
+	return gridZones
+}
+
+// Inverse returns the inverse transformation of c to longitude and latitude.
+func Inverse(c Coord) (float64, float64, error) {
+	if c.Zone < 1 || 60 < c.Zone {
+		return 0, 0, errUndefined
+	}
+	pj, err := ZoneHemisphereTransformation(c.Zone, c.Hemisphere)
+	if err != nil {
+		return 0, 0, err
+	}
+	axisOrder, err := geographicAxisOrder(DatumWGS84)
+	if err != nil {
+		return 0, 0, err
+	}
+	coord, err := pj.Inverse(proj.NewCoord(c.E, c.N, 0, 0))
+	if err != nil {
+		return 0, 0, err
+	}
+	lonLat := axisOrder.ToLonLat(coord)
+	return lonLat.X(), lonLat.Y(), nil
+}
+
+// InverseArray returns the inverse transformation of utmCoords to coordinates
+// whose first two elements are longitude and latitude. The coordinates are
+// transformed with one call to PROJ per zone and hemisphere.
+func InverseArray(utmCoords []Coord) ([]proj.Coord, error) {
+	var keys []zoneHemisphere
+	indexes := make(map[zoneHemisphere][]int)
+	coords := make(map[zoneHemisphere][]proj.Coord)
+	for i, utmCoord := range utmCoords {
+		if utmCoord.Zone < 1 || 60 < utmCoord.Zone {
+			return nil, errUndefined
+		}
+		key := zoneHemisphere{
+			zone:       utmCoord.Zone,
+			hemisphere: utmCoord.Hemisphere,
+		}
+		if _, ok := indexes[key]; !ok {
+			keys = append(keys, key)
+		}
+		indexes[key] = append(indexes[key], i)
+		coords[key] = append(coords[key], proj.NewCoord(utmCoord.E, utmCoord.N, 0, 0))
+	}
+
+	axisOrder, err := geographicAxisOrder(DatumWGS84)
+	if err != nil {
+		return nil, err
+	}
+	lonLats := make([]proj.Coord, len(utmCoords))
+	for _, key := range keys {
+		pj, err := ZoneHemisphereTransformation(key.zone, key.hemisphere)
+		if err != nil {
+			return nil, err
+		}
+		if err := pj.InverseArray(coords[key]); err != nil {
+			return nil, err
+		}
+		axisOrder.ToLonLatArray(coords[key])
+		for j, i := range indexes[key] {
+			lonLats[i] = coords[key][j]
+		}
+	}
+	return lonLats, nil
+}
+
+// Parse parses a UTM coordinate from s. Accepted formats include
+// "32N 500000 5300000", "32U 500000 5300000", and
+// "Zone 32 N, E 500000, N 5300000". The letter after the zone is either a
+// hemisphere, N or S, or a latitude band, C to X excluding I, O, N, and S. As S
+// is always the southern hemisphere, use ParseBand to parse strings with
+// latitude bands, such as those returned by Coord.BandString.
+func Parse(s string) (Coord, error) {
+	return parse(s, parseHemisphereOrBand)
+}
+
+// ParseBand parses a UTM coordinate from s, in the same formats as Parse, where
+// the letter after the zone is always a latitude band, C to X excluding I and
+// O.
+func ParseBand(s string) (Coord, error) {
+	return parse(s, parseBand)
+}
+
 // Zone returns the UTM zone at lon and lat, or -1 if there is no zone at that
 // coordinate.
 func Zone(lon, lat float64) int {
@@ -64,23 +488,297 @@ func Zone(lon, lat float64) int {
 			return 31
 		case 9 <= lon && lon < 21:
 			return 33
-		case 21 <= lon && lon < 30:
+		case 21 <= lon && lon < 33:
 			return 35
+		case 33 <= lon && lon < 42:
+			return 37
 		}
 	}
 	return int((180+lon)/6) + 1
 }
 
+// ZoneBounds returns the bounds, in longitude and latitude, of zone in band,
+// including the exceptions for Norway and Svalbard. If band is zero then it
+// returns the bounds of zone in all bands, with vertically adjacent bounds of
+// equal longitude extent merged.
+func ZoneBounds(zone int, band byte) ([]proj.Bounds, error) {
+	if zone < 1 || 60 < zone {
+		return nil, fmt.Errorf("zone %d: %w", zone, errOutOfRange)
+	}
+	if band != 0 {
+		bandIndex := strings.IndexByte(bandLetters, strings.ToUpper(string(band))[0])
+		if bandIndex < 0 {
+			return nil, fmt.Errorf("%c: %w", band, errSyntax)
+		}
+		bounds, ok := gridZoneBounds(zone, bandIndex)
+		if !ok {
+			return nil, fmt.Errorf("%d%c: %w", zone, band, errUndefined)
+		}
+		return []proj.Bounds{bounds}, nil
+	}
+	var boundsSlice []proj.Bounds
+	for bandIndex := range len(bandLetters) {
+		bounds, ok := gridZoneBounds(zone, bandIndex)
+		if !ok {
+			continue
+		}
+		if n := len(boundsSlice); n > 0 {
+			if last := &boundsSlice[n-1]; last.XMin == bounds.XMin && last.XMax == bounds.XMax && last.YMax == bounds.YMin {
+				last.YMax = bounds.YMax
+				continue
+			}
+		}
+		boundsSlice = append(boundsSlice, bounds)
+	}
+	return boundsSlice, nil
+}
+
+// ZoneForBounds returns the UTM zone and hemisphere at the center of b, whose X
+// and Y are longitude and latitude. If b.XMin is greater than b.XMax then b is
+// taken to cross the antimeridian. It returns a *PolarError if the center of b
+// is in a polar region outside UTM.
+func ZoneForBounds(b proj.Bounds) (int, Hemisphere, error) {
+	lon := (b.XMin + b.XMax) / 2
+	if b.XMin > b.XMax {
+		lon = math.Mod(lon+360, 360) - 180
+	}
+	lat := (b.YMin + b.YMax) / 2
+	zone := Zone(lon, lat)
+	if zone < 0 {
+		return 0, 0, &PolarError{Lon: lon, Lat: lat}
+	}
+	if lat < 0 {
+		return zone, HemisphereSouth, nil
+	}
+	return zone, HemisphereNorth, nil
+}
+
+// ZoneForCoords returns the UTM zone and hemisphere containing the most of
+// coords, whose first two elements are longitude and latitude. Ties are broken
+// in favor of the lowest zone and the northern hemisphere. It returns a
+// *PolarError if any coordinate is in a polar region outside UTM.
+func ZoneForCoords(coords []proj.Coord) (int, Hemisphere, error) {
+	if len(coords) == 0 {
+		return 0, 0, errUndefined
+	}
+	var zoneCounts [61]int
+	southCount := 0
+	for _, coord := range coords {
+		lon, lat := coord[0], coord[1]
+		zone := Zone(lon, lat)
+		if zone < 0 {
+			return 0, 0, &PolarError{Lon: lon, Lat: lat}
+		}
+		zoneCounts[zone]++
+		if lat < 0 {
+			southCount++
+		}
+	}
+	zone := 1
+	for z := 2; z <= 60; z++ {
+		if zoneCounts[z] > zoneCounts[zone] {
+			zone = z
+		}
+	}
+	if 2*southCount > len(coords) {
+		return zone, HemisphereSouth, nil
+	}
+	return zone, HemisphereNorth, nil
+}
+
+// ZoneHemisphereTransformation returns the transformation from EPSG:4326 to
+// the given UTM zone and hemisphere.
+func ZoneHemisphereTransformation(zone int, hemisphere Hemisphere) (*proj.PJ, error) {
+	return ZoneTransformationFor(DatumWGS84, zone, hemisphere == HemisphereSouth)
+}
+
 // ZoneTransformation returns the transformation from EPSG:4326 to the given UTM
-// zone.
+// zone in the northern hemisphere.
 func ZoneTransformation(zone int) (*proj.PJ, error) {
-	if pj, ok := transformationCache.Load(zone); ok {
+	return ZoneHemisphereTransformation(zone, HemisphereNorth)
+}
+
+// ZoneTransformationFor returns the transformation from the geographic CRS of
+// datum to the projected CRS of the given UTM zone and hemisphere on datum, as
+// identified by EPSGCode. The geographic CRS is EPSG:4326 for WGS84, EPSG:4269
+// for NAD83, and EPSG:4258 for ETRS89.
+func ZoneTransformationFor(datum Datum, zone int, south bool) (*proj.PJ, error) {
+	key := transformationCacheKey{
+		datum: datum,
+		zone:  zone,
+		south: south,
+	}
+	if pj, ok := transformationCache.Load(key); ok {
+		return pj.(*proj.PJ), nil //nolint:forcetypeassert
+	}
+	epsgCode := EPSGCode(zone, south, datum)
+	if epsgCode < 0 {
+		return nil, fmt.Errorf("%s zone %d: %w", datum, zone, errUndefined)
+	}
+	sourceCRS := "epsg:" + strconv.Itoa(datum.geographicEPSGCode())
+	targetCRS := "epsg:" + strconv.Itoa(epsgCode)
+	pj, err := proj.NewCRSToCRS(sourceCRS, targetCRS, nil)
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := transformationCache.LoadOrStore(key, pj)
+	return actual.(*proj.PJ), nil //nolint:forcetypeassert
+}
+
+// geographicAxisOrder returns the axis order of the geographic CRS of datum.
+func geographicAxisOrder(datum Datum) (proj.AxisOrder, error) {
+	if axisOrder, ok := axisOrderCache.Load(datum); ok {
+		return axisOrder.(proj.AxisOrder), nil //nolint:forcetypeassert
+	}
+	crs, err := proj.New("epsg:" + strconv.Itoa(datum.geographicEPSGCode()))
+	if err != nil {
+		return proj.AxisOrderUnknown, err
+	}
+	axisOrder, err := crs.AxisOrder()
+	if err != nil {
+		return proj.AxisOrderUnknown, err
+	}
+	actual, _ := axisOrderCache.LoadOrStore(datum, axisOrder)
+	return actual.(proj.AxisOrder), nil //nolint:forcetypeassert
+}
+
+// gridZoneBounds returns the bounds of zone in the band at bandIndex in
+// bandLetters, and whether zone exists in that band. The exceptions to the
+// regular grid cover whole bands and have boundaries at multiples of 3° of
+// longitude, so the bounds are found by sampling Zone in each 3° column.
+func gridZoneBounds(zone, bandIndex int) (proj.Bounds, bool) {
+	latMin := float64(-80 + 8*bandIndex)
+	latMax := latMin + 8
+	if bandIndex == len(bandLetters)-1 {
+		latMax = 84
+	}
+	lat := (latMin + latMax) / 2
+	lonMin, lonMax := math.Inf(1), math.Inf(-1)
+	for lon := -180.0; lon < 180; lon += 3 {
+		if Zone(lon+1.5, lat) == zone {
+			lonMin = min(lonMin, lon)
+			lonMax = max(lonMax, lon+3)
+		}
+	}
+	if lonMin > lonMax {
+		return proj.Bounds{}, false
+	}
+	return proj.Bounds{
+		XMin: lonMin,
+		YMin: latMin,
+		XMax: lonMax,
+		YMax: latMax,
+	}, true
+}
+
+// parse parses a UTM coordinate from s, using parseLetter to parse the letter
+// after the zone.
+func parse(s string, parseLetter func(byte) (Hemisphere, error)) (Coord, error) {
+	for _, coordRegexp := range coordRegexps {
+		m := coordRegexp.FindStringSubmatch(s)
+		if m == nil {
+			continue
+		}
+		zone, err := strconv.Atoi(m[1])
+		if err != nil {
+			return Coord{}, fmt.Errorf("%q: %w", s, err)
+		}
+		if zone < 1 || 60 < zone {
+			return Coord{}, fmt.Errorf("%q: zone %d: %w", s, zone, errOutOfRange)
+		}
+		hemisphere, err := parseLetter(m[2][0])
+		if err != nil {
+			return Coord{}, fmt.Errorf("%q: %w", s, err)
+		}
+		e, err := strconv.ParseFloat(m[3], 64)
+		if err != nil {
+			return Coord{}, fmt.Errorf("%q: %w", s, err)
+		}
+		if e <= 0 || 1_000_000 <= e {
+			return Coord{}, fmt.Errorf("%q: easting %s: %w", s, m[3], errOutOfRange)
+		}
+		n, err := strconv.ParseFloat(m[4], 64)
+		if err != nil {
+			return Coord{}, fmt.Errorf("%q: %w", s, err)
+		}
+		if n < 0 || 10_000_000 < n {
+			return Coord{}, fmt.Errorf("%q: northing %s: %w", s, m[4], errOutOfRange)
+		}
+		return Coord{
+			Zone:       zone,
+			Hemisphere: hemisphere,
+			E:          e,
+			N:          n,
+		}, nil
+	}
+	return Coord{}, fmt.Errorf("%q: %w", s, errSyntax)
+}
+
+// parseBand returns the hemisphere of the latitude band letter c.
+func parseBand(c byte) (Hemisphere, error) {
+	switch c := strings.ToUpper(string(c)); {
+	case c == "I" || c == "O":
+		return 0, fmt.Errorf("%s: %w", c, errSyntax)
+	case "C" <= c && c <= "M":
+		return HemisphereSouth, nil
+	case "N" <= c && c <= "X":
+		return HemisphereNorth, nil
+	default:
+		return 0, fmt.Errorf("%s: %w", c, errSyntax)
+	}
+}
+
+// parseHemisphereOrBand returns the hemisphere of the hemisphere or latitude
+// band letter c. S is the southern hemisphere, not the northern latitude band.
+func parseHemisphereOrBand(c byte) (Hemisphere, error) {
+	switch strings.ToUpper(string(c)) {
+	case "N":
+		return HemisphereNorth, nil
+	case "S":
+		return HemisphereSouth, nil
+	default:
+		return parseBand(c)
+	}
+}
+
+// zoneDistortion returns the distortion at lon and lat in zone. The scale
+// factor is the ratio of the projected length of a short arc of the parallel
+// through lat to its length on the WGS84 ellipsoid.
+func zoneDistortion(lon, lat float64, zone int) (Distortion, error) {
+	pj, err := zoneProjection(zone)
+	if err != nil {
+		return Distortion{}, err
+	}
+	const deltaLambda = 1e-5 * math.Pi / 180
+	lambda := lon * math.Pi / 180
+	phi := lat * math.Pi / 180
+	coords := []proj.Coord{
+		proj.NewCoord(lambda-deltaLambda, phi, 0, 0),
+		proj.NewCoord(lambda+deltaLambda, phi, 0, 0),
+	}
+	if err := pj.ForwardArray(coords); err != nil {
+		return Distortion{}, err
+	}
+	sinPhi := math.Sin(phi)
+	primeVerticalRadius := wgs84SemiMajorAxis / math.Sqrt(1-wgs84EccentricitySquared*sinPhi*sinPhi)
+	k := math.Hypot(coords[1].X()-coords[0].X(), coords[1].Y()-coords[0].Y()) / (2 * deltaLambda * primeVerticalRadius * math.Cos(phi))
+	centralMeridian := float64(6*zone - 183)
+	deltaLon := math.Mod(lon-centralMeridian+540, 360) - 180
+	return Distortion{
+		Outside:    max(math.Abs(deltaLon)-3, 0),
+		ScaleError: k - 1,
+	}, nil
+}
+
+// zoneProjection returns the UTM projection of zone on the WGS84 ellipsoid.
+func zoneProjection(zone int) (*proj.PJ, error) {
+	if pj, ok := projectionCache.Load(zone); ok {
 		return pj.(*proj.PJ), nil //nolint:forcetypeassert
 	}
-	pj, err := proj.NewCRSToCRS("epsg:4326", fmt.Sprintf("+proj=utm +zone=%d", zone), nil)
+	pj, err := proj.New("+proj=utm +zone=" + strconv.Itoa(zone) + " +ellps=WGS84")
 	if err != nil {
 		return nil, err
 	}
-	actual, _ := transformationCache.LoadOrStore(zone, pj)
+	actual, _ := projectionCache.LoadOrStore(zone, pj)
 	return actual.(*proj.PJ), nil //nolint:forcetypeassert
 }
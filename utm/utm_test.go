@@ -1,12 +1,16 @@
 package utm_test
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"strconv"
 	"testing"
 
 	"github.com/alecthomas/assert/v2"
 
+	"github.com/twpayne/go-proj/v11"
 	"github.com/twpayne/go-proj/v11/utm"
 )
 
@@ -28,9 +32,10 @@ func TestCoord_String(t *testing.T) {
 		{
 			name: "Sydney",
 			coord: utm.Coord{
-				Zone: 56,
-				E:    333_504,
-				N:    6_251_170 - 10_000_000,
+				Zone:       56,
+				Hemisphere: utm.HemisphereSouth,
+				E:          333_504,
+				N:          6_251_170,
 			},
 			expected: "56S 333504 6251170",
 		},
@@ -41,6 +46,204 @@ func TestCoord_String(t *testing.T) {
 	}
 }
 
+func TestCoord_BandString(t *testing.T) {
+	for _, tc := range []struct {
+		coord    utm.Coord
+		expected string
+	}{
+		{
+			coord: utm.Coord{
+				Zone: 17,
+				E:    630_084,
+				N:    4_833_438,
+			},
+			expected: "17T 630084 4833438",
+		},
+		{
+			coord: utm.Coord{
+				Zone:       56,
+				Hemisphere: utm.HemisphereSouth,
+				E:          333_504,
+				N:          6_251_170,
+			},
+			expected: "56H 333504 6251170",
+		},
+		{
+			coord: utm.Coord{
+				Zone: 11,
+				E:    500_000,
+				N:    3_800_000,
+			},
+			expected: "11S 500000 3800000",
+		},
+		{
+			coord: utm.Coord{
+				Zone: 48,
+				E:    500_000,
+				N:    150_000,
+			},
+			expected: "48N 500000 150000",
+		},
+	} {
+		t.Run(tc.expected, func(t *testing.T) {
+			actual, err := tc.coord.BandString()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+
+			parsedCoord, err := utm.ParseBand(actual)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.coord, parsedCoord)
+		})
+	}
+}
+
+func TestBand(t *testing.T) {
+	for _, tc := range []struct {
+		lat         float64
+		expected    byte
+		expectedErr bool
+	}{
+		{lat: -90, expectedErr: true},
+		{lat: -80.000001, expectedErr: true},
+		{lat: -80, expected: 'C'},
+		{lat: -72.000001, expected: 'C'},
+		{lat: -72, expected: 'D'},
+		{lat: -0.000001, expected: 'M'},
+		{lat: 0, expected: 'N'},
+		{lat: 8, expected: 'P'},
+		{lat: 47.5, expected: 'T'},
+		{lat: 48, expected: 'U'},
+		{lat: 71.999999, expected: 'W'},
+		{lat: 72, expected: 'X'},
+		{lat: 80, expected: 'X'},
+		{lat: 83.999999, expected: 'X'},
+		{lat: 84, expectedErr: true},
+		{lat: 90, expectedErr: true},
+	} {
+		t.Run(strconv.FormatFloat(tc.lat, 'f', -1, 64), func(t *testing.T) {
+			actual, err := utm.Band(tc.lat)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, string(tc.expected), string(actual))
+		})
+	}
+}
+
+func TestDesignator(t *testing.T) {
+	for _, tc := range []struct {
+		lon, lat float64
+		expected string
+	}{
+		{lon: 0, lat: 0, expected: "31N"},
+		{lon: 16.363449, lat: 48.210033, expected: "33U"},
+		{lon: 5.727882, lat: 58.961764, expected: "32V"},
+		{lon: 15.6356, lat: 78.2232, expected: "33X"},
+		{lon: 8, lat: 78, expected: "31X"},
+		{lon: 22, lat: 78, expected: "35X"},
+		{lon: 31.5, lat: 78, expected: "35X"},
+		{lon: 34, lat: 78, expected: "37X"},
+		{lon: 151.192017, lat: -33.895953, expected: "56H"},
+		{lon: 0, lat: -85, expected: ""},
+		{lon: 0, lat: 85, expected: ""},
+	} {
+		t.Run(fmt.Sprintf("%f_%f", tc.lon, tc.lat), func(t *testing.T) {
+			assert.Equal(t, tc.expected, utm.Designator(tc.lon, tc.lat))
+		})
+	}
+}
+
+func TestEPSGCode(t *testing.T) {
+	for _, tc := range []struct {
+		zone     int
+		south    bool
+		datum    utm.Datum
+		expected int
+	}{
+		{zone: 1, datum: utm.DatumWGS84, expected: 32601},
+		{zone: 32, datum: utm.DatumWGS84, expected: 32632},
+		{zone: 60, datum: utm.DatumWGS84, expected: 32660},
+		{zone: 1, south: true, datum: utm.DatumWGS84, expected: 32701},
+		{zone: 56, south: true, datum: utm.DatumWGS84, expected: 32756},
+		{zone: 0, datum: utm.DatumWGS84, expected: -1},
+		{zone: 61, datum: utm.DatumWGS84, expected: -1},
+		{zone: 1, datum: utm.DatumNAD83, expected: 26901},
+		{zone: 17, datum: utm.DatumNAD83, expected: 26917},
+		{zone: 23, datum: utm.DatumNAD83, expected: 26923},
+		{zone: 24, datum: utm.DatumNAD83, expected: -1},
+		{zone: 17, south: true, datum: utm.DatumNAD83, expected: -1},
+		{zone: 27, datum: utm.DatumETRS89, expected: -1},
+		{zone: 28, datum: utm.DatumETRS89, expected: 25828},
+		{zone: 32, datum: utm.DatumETRS89, expected: 25832},
+		{zone: 38, datum: utm.DatumETRS89, expected: 25838},
+		{zone: 39, datum: utm.DatumETRS89, expected: -1},
+		{zone: 32, south: true, datum: utm.DatumETRS89, expected: -1},
+		{zone: 32, datum: utm.Datum(-1), expected: -1},
+	} {
+		t.Run(fmt.Sprintf("%s_%d_%t", tc.datum, tc.zone, tc.south), func(t *testing.T) {
+			assert.Equal(t, tc.expected, utm.EPSGCode(tc.zone, tc.south, tc.datum))
+		})
+	}
+}
+
+func TestZoneTransformationFor(t *testing.T) {
+	for _, tc := range []struct {
+		datum                 utm.Datum
+		zone                  int
+		south                 bool
+		expectedSourceCRSName string
+		expectedTargetCRSName string
+		expectedTargetCRSCode string
+	}{
+		{
+			datum:                 utm.DatumWGS84,
+			zone:                  56,
+			south:                 true,
+			expectedSourceCRSName: "WGS 84",
+			expectedTargetCRSName: "WGS 84 / UTM zone 56S",
+			expectedTargetCRSCode: "32756",
+		},
+		{
+			datum:                 utm.DatumNAD83,
+			zone:                  17,
+			expectedSourceCRSName: "NAD83",
+			expectedTargetCRSName: "NAD83 / UTM zone 17N",
+			expectedTargetCRSCode: "26917",
+		},
+		{
+			datum:                 utm.DatumETRS89,
+			zone:                  32,
+			expectedSourceCRSName: "ETRS89",
+			expectedTargetCRSName: "ETRS89 / UTM zone 32N",
+			expectedTargetCRSCode: "25832",
+		},
+	} {
+		t.Run(tc.expectedTargetCRSName, func(t *testing.T) {
+			pj, err := utm.ZoneTransformationFor(tc.datum, tc.zone, tc.south)
+			assert.NoError(t, err)
+
+			sourceCRS, err := pj.SourceCRS()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedSourceCRSName, sourceCRS.Name())
+
+			targetCRS, err := pj.TargetCRS()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedTargetCRSName, targetCRS.Name())
+			assert.Equal(t, "EPSG", targetCRS.IDAuthName(0))
+			assert.Equal(t, tc.expectedTargetCRSCode, targetCRS.IDCode(0))
+
+			cachedPJ, err := utm.ZoneTransformationFor(tc.datum, tc.zone, tc.south)
+			assert.NoError(t, err)
+			assert.True(t, pj == cachedPJ)
+		})
+	}
+
+	_, err := utm.ZoneTransformationFor(utm.DatumETRS89, 17, false)
+	assert.Error(t, err)
+}
+
 func TestForward(t *testing.T) {
 	for _, tc := range []struct {
 		name     string
@@ -67,14 +270,66 @@ func TestForward(t *testing.T) {
 				N:    4_834_275,
 			},
 		},
+		{
+			name: "Equator",
+			lon:  9,
+			lat:  0,
+			expected: utm.Coord{
+				Zone: 32,
+				E:    500_000,
+				N:    0,
+			},
+		},
+		{
+			name: "Just south of the equator",
+			lon:  9,
+			lat:  -1e-9,
+			expected: utm.Coord{
+				Zone:       32,
+				Hemisphere: utm.HemisphereSouth,
+				E:          500_000,
+				N:          10_000_000,
+			},
+		},
 		{
 			name: "Sydney",
 			lon:  degrees(151, 12, 0),
 			lat:  -degrees(33, 52, 0),
 			expected: utm.Coord{
-				Zone: 56,
-				E:    333_504,
-				N:    6_251_170 - 10_000_000,
+				Zone:       56,
+				Hemisphere: utm.HemisphereSouth,
+				E:          333_504,
+				N:          6_251_170,
+			},
+		},
+		{
+			name: "Svalbard zone 35X",
+			lon:  22,
+			lat:  78,
+			expected: utm.Coord{
+				Zone: 35,
+				E:    384_085,
+				N:    8_663_320,
+			},
+		},
+		{
+			name: "Svalbard zone 35X east of 30°E",
+			lon:  31.5,
+			lat:  78,
+			expected: utm.Coord{
+				Zone: 35,
+				E:    604_346,
+				N:    8_662_380,
+			},
+		},
+		{
+			name: "Svalbard zone 37X",
+			lon:  34,
+			lat:  78,
+			expected: utm.Coord{
+				Zone: 37,
+				E:    384_085,
+				N:    8_663_320,
 			},
 		},
 	} {
@@ -82,12 +337,480 @@ func TestForward(t *testing.T) {
 			actual, err := utm.Forward(tc.lon, tc.lat)
 			assert.NoError(t, err)
 			assert.Equal(t, tc.expected.Zone, actual.Zone)
+			assert.Equal(t, tc.expected.Hemisphere, actual.Hemisphere)
 			assertWithin(t, 1, tc.expected.E, actual.E)
 			assertWithin(t, 1, tc.expected.N, actual.N)
 		})
 	}
 }
 
+func TestForward_epsg(t *testing.T) {
+	for _, tc := range []struct {
+		lon, lat float64
+		epsg     string
+	}{
+		{lon: -79.386, lat: 43.643, epsg: "EPSG:32617"},
+		{lon: 151.2, lat: -33.867, epsg: "EPSG:32756"},
+		{lon: -43.199158, lat: -22.911851, epsg: "EPSG:32723"},
+	} {
+		t.Run(tc.epsg, func(t *testing.T) {
+			pj, err := proj.NewCRSToCRS("EPSG:4326", tc.epsg, nil)
+			assert.NoError(t, err)
+			expected, err := pj.Forward(proj.NewCoord(tc.lat, tc.lon, 0, 0))
+			assert.NoError(t, err)
+
+			actual, err := utm.Forward(tc.lon, tc.lat)
+			assert.NoError(t, err)
+			assertWithin(t, 1e-3, expected.X(), actual.E)
+			assertWithin(t, 1e-3, expected.Y(), actual.N)
+		})
+	}
+}
+
+func TestForward_polar(t *testing.T) {
+	for _, lat := range []float64{-90, -80.000001, 84, 90} {
+		_, err := utm.Forward(0, lat)
+		var polarError *utm.PolarError
+		assert.True(t, errors.As(err, &polarError))
+		assert.Equal(t, &utm.PolarError{Lon: 0, Lat: lat}, polarError)
+	}
+}
+
+func TestForwardArrayInverseArray(t *testing.T) {
+	lonLats := []proj.Coord{
+		proj.NewCoord(-degrees(79, 23, 13.7), degrees(43, 38, 33.24), 0, 0),
+		proj.NewCoord(degrees(151, 12, 0), -degrees(33, 52, 0), 0, 0),
+		proj.NewCoord(-degrees(79, 22, 48), degrees(43, 39, 0), 0, 0),
+		proj.NewCoord(5.727882, 58.961764, 0, 0),
+		proj.NewCoord(15.6356, 78.2232, 0, 0),
+		proj.NewCoord(9, -1e-9, 0, 0),
+		proj.NewCoord(9, 0, 0, 0),
+	}
+
+	actual, err := utm.ForwardArray(lonLats)
+	assert.NoError(t, err)
+	assert.Equal(t, len(lonLats), len(actual))
+	for i, lonLat := range lonLats {
+		expected, err := utm.Forward(lonLat[0], lonLat[1])
+		assert.NoError(t, err)
+		assert.Equal(t, expected.Zone, actual[i].Zone)
+		assert.Equal(t, expected.Hemisphere, actual[i].Hemisphere)
+		assertWithin(t, 1e-6, expected.E, actual[i].E)
+		assertWithin(t, 1e-6, expected.N, actual[i].N)
+	}
+
+	actualLonLats, err := utm.InverseArray(actual)
+	assert.NoError(t, err)
+	assert.Equal(t, len(lonLats), len(actualLonLats))
+	for i, lonLat := range lonLats {
+		assertWithin(t, 1e-9, lonLat[0], actualLonLats[i][0])
+		assertWithin(t, 1e-9, lonLat[1], actualLonLats[i][1])
+	}
+
+	empty, err := utm.ForwardArray(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(empty))
+
+	_, err = utm.ForwardArray([]proj.Coord{proj.NewCoord(0, 0, 0, 0), proj.NewCoord(0, 89, 0, 0)})
+	var polarError *utm.PolarError
+	assert.True(t, errors.As(err, &polarError))
+
+	_, err = utm.InverseArray([]utm.Coord{{Zone: 0}})
+	assert.Error(t, err)
+}
+
+func TestForwardInZone(t *testing.T) {
+	for _, tc := range []struct {
+		name               string
+		lon                float64
+		lat                float64
+		zone               int
+		hemisphere         utm.Hemisphere
+		expectedOutside    float64
+		expectedScaleError float64
+	}{
+		{
+			name:               "central_meridian",
+			lon:                3,
+			lat:                0,
+			zone:               31,
+			expectedScaleError: -0.0004,
+		},
+		{
+			name:               "zone_edge",
+			lon:                6,
+			lat:                0,
+			zone:               31,
+			expectedScaleError: 0.000981062,
+		},
+		{
+			name:               "outside_east",
+			lon:                12,
+			lat:                0,
+			zone:               31,
+			expectedOutside:    6,
+			expectedScaleError: 0.012145705,
+		},
+		{
+			name:               "outside_west",
+			lon:                -6,
+			lat:                52,
+			zone:               31,
+			expectedOutside:    6,
+			expectedScaleError: 0.004280161,
+		},
+		{
+			name:               "20°_east_equator",
+			lon:                23,
+			lat:                0,
+			zone:               31,
+			expectedOutside:    17,
+			expectedScaleError: 0.064227425,
+		},
+		{
+			name:               "20°_east_45°N",
+			lon:                23,
+			lat:                45,
+			zone:               31,
+			expectedOutside:    17,
+			expectedScaleError: 0.030275329,
+		},
+		{
+			name:               "20°_west_60°N",
+			lon:                -17,
+			lat:                60,
+			zone:               31,
+			expectedOutside:    17,
+			expectedScaleError: 0.014566060,
+		},
+		{
+			name:               "other_hemisphere",
+			lon:                9,
+			lat:                -1,
+			zone:               32,
+			expectedScaleError: -0.0004,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, distortion, err := utm.ForwardInZone(tc.lon, tc.lat, tc.zone, tc.hemisphere)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.zone, actual.Zone)
+			assert.Equal(t, tc.hemisphere, actual.Hemisphere)
+			assertWithin(t, 1e-9, tc.expectedOutside, distortion.Outside)
+			assertWithin(t, 1e-8, tc.expectedScaleError, distortion.ScaleError)
+			if tc.lat < 0 && tc.hemisphere == utm.HemisphereNorth {
+				assert.True(t, actual.N < 0)
+			}
+			if utm.Zone(tc.lon, tc.lat) == tc.zone && (tc.lat < 0) == (tc.hemisphere == utm.HemisphereSouth) {
+				expected, err := utm.Forward(tc.lon, tc.lat)
+				assert.NoError(t, err)
+				assertWithin(t, 1e-6, expected.E, actual.E)
+				assertWithin(t, 1e-6, expected.N, actual.N)
+			}
+			lon, lat, err := actual.LonLat()
+			assert.NoError(t, err)
+			assertWithin(t, 1e-9, tc.lon, lon)
+			assertWithin(t, 1e-9, tc.lat, lat)
+		})
+	}
+
+	_, _, err := utm.ForwardInZone(0, 0, 61, utm.HemisphereNorth)
+	assert.Error(t, err)
+}
+
+func TestForwardArrayInZone(t *testing.T) {
+	lonLats := []proj.Coord{
+		proj.NewCoord(3, 0, 0, 0),
+		proj.NewCoord(12, 0, 0, 0),
+		proj.NewCoord(-6, 52, 0, 0),
+	}
+	actual, distortions, err := utm.ForwardArrayInZone(lonLats, 31, utm.HemisphereNorth)
+	assert.NoError(t, err)
+	assert.Equal(t, len(lonLats), len(actual))
+	assert.Equal(t, len(lonLats), len(distortions))
+	for i, lonLat := range lonLats {
+		expected, expectedDistortion, err := utm.ForwardInZone(lonLat[0], lonLat[1], 31, utm.HemisphereNorth)
+		assert.NoError(t, err)
+		assert.Equal(t, expected.Zone, actual[i].Zone)
+		assertWithin(t, 1e-6, expected.E, actual[i].E)
+		assertWithin(t, 1e-6, expected.N, actual[i].N)
+		assert.Equal(t, expectedDistortion, distortions[i])
+	}
+}
+
+func TestGridZones(t *testing.T) {
+	gridZones := utm.GridZones()
+	assert.Equal(t, 1197, len(gridZones))
+	area := 0.0
+	designators := make(map[string]struct{})
+	for _, gridZone := range gridZones {
+		designators[gridZone.Designator] = struct{}{}
+		bounds := gridZone.Bounds
+		area += (bounds.XMax - bounds.XMin) * (bounds.YMax - bounds.YMin)
+		lon := (bounds.XMin + bounds.XMax) / 2
+		lat := (bounds.YMin + bounds.YMax) / 2
+		assert.Equal(t, gridZone.Designator, utm.Designator(lon, lat))
+		assert.Equal(t, strconv.Itoa(gridZone.Zone)+string(gridZone.Band), gridZone.Designator)
+		zoneBounds, err := utm.ZoneBounds(gridZone.Zone, gridZone.Band)
+		assert.NoError(t, err)
+		assert.Equal(t, []proj.Bounds{bounds}, zoneBounds)
+	}
+	assert.Equal(t, len(gridZones), len(designators))
+	assert.Equal(t, 360*164, area)
+	for _, designator := range []string{"32X", "34X", "36X"} {
+		_, ok := designators[designator]
+		assert.False(t, ok)
+	}
+}
+
+func TestInverse(t *testing.T) {
+	for _, tc := range []struct {
+		name                     string
+		coord                    utm.Coord
+		expectedLon, expectedLat float64
+	}{
+		{
+			name: "CN Tower",
+			coord: utm.Coord{
+				Zone: 17,
+				E:    630_084,
+				N:    4_833_438,
+			},
+			expectedLon: -degrees(79, 23, 13.7),
+			expectedLat: degrees(43, 38, 33.24),
+		},
+		{
+			name: "Sydney",
+			coord: utm.Coord{
+				Zone:       56,
+				Hemisphere: utm.HemisphereSouth,
+				E:          333_504,
+				N:          6_251_170,
+			},
+			expectedLon: degrees(151, 12, 0),
+			expectedLat: -degrees(33, 52, 0),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			actualLon, actualLat, err := utm.Inverse(tc.coord)
+			assert.NoError(t, err)
+			assertWithin(t, 2e-5, tc.expectedLon, actualLon)
+			assertWithin(t, 2e-5, tc.expectedLat, actualLat)
+
+			forwardCoord, err := utm.Forward(actualLon, actualLat)
+			assert.NoError(t, err)
+			assertWithin(t, 1e-3, tc.coord.E, forwardCoord.E)
+			assertWithin(t, 1e-3, tc.coord.N, forwardCoord.N)
+
+			lon, lat, err := tc.coord.LonLat()
+			assert.NoError(t, err)
+			assert.Equal(t, actualLon, lon)
+			assert.Equal(t, actualLat, lat)
+		})
+	}
+
+	for _, zone := range []int{-1, 0, 61} {
+		_, _, err := utm.Inverse(utm.Coord{Zone: zone})
+		assert.Error(t, err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		s           string
+		expected    utm.Coord
+		expectedErr bool
+	}{
+		{
+			s: "32N 500000 5300000",
+			expected: utm.Coord{
+				Zone: 32,
+				E:    500_000,
+				N:    5_300_000,
+			},
+		},
+		{
+			s: "32U 500000 5300000",
+			expected: utm.Coord{
+				Zone: 32,
+				E:    500_000,
+				N:    5_300_000,
+			},
+		},
+		{
+			s: "Zone 32 N, E 500000, N 5300000",
+			expected: utm.Coord{
+				Zone: 32,
+				E:    500_000,
+				N:    5_300_000,
+			},
+		},
+		{
+			s: "56S 333504 6251170",
+			expected: utm.Coord{
+				Zone:       56,
+				Hemisphere: utm.HemisphereSouth,
+				E:          333_504,
+				N:          6_251_170,
+			},
+		},
+		{
+			s: "56h 333504.5 6251170.25",
+			expected: utm.Coord{
+				Zone:       56,
+				Hemisphere: utm.HemisphereSouth,
+				E:          333_504.5,
+				N:          6_251_170.25,
+			},
+		},
+		{
+			s: "zone 56 H, E 333504, N 6251170",
+			expected: utm.Coord{
+				Zone:       56,
+				Hemisphere: utm.HemisphereSouth,
+				E:          333_504,
+				N:          6_251_170,
+			},
+		},
+		{
+			s:           "",
+			expectedErr: true,
+		},
+		{
+			s:           "0N 500000 5300000",
+			expectedErr: true,
+		},
+		{
+			s:           "61N 500000 5300000",
+			expectedErr: true,
+		},
+		{
+			s:           "32O 500000 5300000",
+			expectedErr: true,
+		},
+		{
+			s:           "32Y 500000 5300000",
+			expectedErr: true,
+		},
+		{
+			s:           "32N 1000000 5300000",
+			expectedErr: true,
+		},
+		{
+			s:           "32N 500000 10000001",
+			expectedErr: true,
+		},
+		{
+			s:           "32N 500000",
+			expectedErr: true,
+		},
+	} {
+		t.Run(tc.s, func(t *testing.T) {
+			actual, err := utm.Parse(tc.s)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestParseBand(t *testing.T) {
+	for _, tc := range []struct {
+		s           string
+		expected    utm.Coord
+		expectedErr bool
+	}{
+		{
+			s: "11S 500000 3800000",
+			expected: utm.Coord{
+				Zone: 11,
+				E:    500_000,
+				N:    3_800_000,
+			},
+		},
+		{
+			s: "48n 500000 150000",
+			expected: utm.Coord{
+				Zone: 48,
+				E:    500_000,
+				N:    150_000,
+			},
+		},
+		{
+			s: "zone 56 H, E 333504, N 6251170",
+			expected: utm.Coord{
+				Zone:       56,
+				Hemisphere: utm.HemisphereSouth,
+				E:          333_504,
+				N:          6_251_170,
+			},
+		},
+		{
+			s: "32M 500000 9900000",
+			expected: utm.Coord{
+				Zone:       32,
+				Hemisphere: utm.HemisphereSouth,
+				E:          500_000,
+				N:          9_900_000,
+			},
+		},
+		{
+			s:           "32I 500000 5300000",
+			expectedErr: true,
+		},
+		{
+			s:           "32Y 500000 5300000",
+			expectedErr: true,
+		},
+	} {
+		t.Run(tc.s, func(t *testing.T) {
+			actual, err := utm.ParseBand(tc.s)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestCoord_MarshalText(t *testing.T) {
+	for _, coord := range []utm.Coord{
+		{
+			Zone: 17,
+			E:    630_084,
+			N:    4_833_438,
+		},
+		{
+			Zone:       56,
+			Hemisphere: utm.HemisphereSouth,
+			E:          333_504,
+			N:          6_251_170,
+		},
+	} {
+		t.Run(coord.String(), func(t *testing.T) {
+			parsedCoord, err := utm.Parse(coord.String())
+			assert.NoError(t, err)
+			assert.Equal(t, coord, parsedCoord)
+
+			data, err := json.Marshal(coord)
+			assert.NoError(t, err)
+			assert.Equal(t, strconv.Quote(coord.String()), string(data))
+
+			var unmarshaledCoord utm.Coord
+			assert.NoError(t, json.Unmarshal(data, &unmarshaledCoord))
+			assert.Equal(t, coord, unmarshaledCoord)
+		})
+	}
+
+	var coord utm.Coord
+	assert.Error(t, coord.UnmarshalText([]byte("invalid")))
+}
+
 func TestZone(t *testing.T) {
 	for _, tc := range []struct {
 		lon, lat float64
@@ -128,6 +851,41 @@ func TestZone(t *testing.T) {
 			lat:      -33.895953,
 			expected: 56,
 		},
+		{
+			lon:      22,
+			lat:      78,
+			expected: 35,
+		},
+		{
+			lon:      31.5,
+			lat:      78,
+			expected: 35,
+		},
+		{
+			lon:      34,
+			lat:      78,
+			expected: 37,
+		},
+		{
+			lon:      30,
+			lat:      72,
+			expected: 35,
+		},
+		{
+			lon:      32.999,
+			lat:      83.999,
+			expected: 35,
+		},
+		{
+			lon:      33,
+			lat:      72,
+			expected: 37,
+		},
+		{
+			lon:      30,
+			lat:      71.999,
+			expected: 36,
+		},
 	} {
 		t.Run(fmt.Sprintf("%f_%f", tc.lon, tc.lat), func(t *testing.T) {
 			assert.Equal(t, tc.expected, utm.Zone(tc.lon, tc.lat))
@@ -135,6 +893,263 @@ func TestZone(t *testing.T) {
 	}
 }
 
+func TestZoneBounds(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		zone        int
+		band        byte
+		expected    []proj.Bounds
+		expectedErr bool
+	}{
+		{
+			name: "33U",
+			zone: 33,
+			band: 'U',
+			expected: []proj.Bounds{
+				{XMin: 12, YMin: 48, XMax: 18, YMax: 56},
+			},
+		},
+		{
+			name: "1C",
+			zone: 1,
+			band: 'c',
+			expected: []proj.Bounds{
+				{XMin: -180, YMin: -80, XMax: -174, YMax: -72},
+			},
+		},
+		{
+			name: "31V",
+			zone: 31,
+			band: 'V',
+			expected: []proj.Bounds{
+				{XMin: 0, YMin: 56, XMax: 3, YMax: 64},
+			},
+		},
+		{
+			name: "32V",
+			zone: 32,
+			band: 'V',
+			expected: []proj.Bounds{
+				{XMin: 3, YMin: 56, XMax: 12, YMax: 64},
+			},
+		},
+		{
+			name: "31X",
+			zone: 31,
+			band: 'X',
+			expected: []proj.Bounds{
+				{XMin: 0, YMin: 72, XMax: 9, YMax: 84},
+			},
+		},
+		{
+			name: "35X",
+			zone: 35,
+			band: 'X',
+			expected: []proj.Bounds{
+				{XMin: 21, YMin: 72, XMax: 33, YMax: 84},
+			},
+		},
+		{
+			name: "37X",
+			zone: 37,
+			band: 'X',
+			expected: []proj.Bounds{
+				{XMin: 33, YMin: 72, XMax: 42, YMax: 84},
+			},
+		},
+		{
+			name:        "32X",
+			zone:        32,
+			band:        'X',
+			expectedErr: true,
+		},
+		{
+			name: "1",
+			zone: 1,
+			expected: []proj.Bounds{
+				{XMin: -180, YMin: -80, XMax: -174, YMax: 84},
+			},
+		},
+		{
+			name: "31",
+			zone: 31,
+			expected: []proj.Bounds{
+				{XMin: 0, YMin: -80, XMax: 6, YMax: 56},
+				{XMin: 0, YMin: 56, XMax: 3, YMax: 64},
+				{XMin: 0, YMin: 64, XMax: 6, YMax: 72},
+				{XMin: 0, YMin: 72, XMax: 9, YMax: 84},
+			},
+		},
+		{
+			name: "32",
+			zone: 32,
+			expected: []proj.Bounds{
+				{XMin: 6, YMin: -80, XMax: 12, YMax: 56},
+				{XMin: 3, YMin: 56, XMax: 12, YMax: 64},
+				{XMin: 6, YMin: 64, XMax: 12, YMax: 72},
+			},
+		},
+		{
+			name:        "zone_out_of_range",
+			zone:        61,
+			band:        'U',
+			expectedErr: true,
+		},
+		{
+			name:        "invalid_band",
+			zone:        33,
+			band:        'I',
+			expectedErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := utm.ZoneBounds(tc.zone, tc.band)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestZoneForBounds(t *testing.T) {
+	for _, tc := range []struct {
+		name               string
+		bounds             proj.Bounds
+		expectedZone       int
+		expectedHemisphere utm.Hemisphere
+		expectedErr        bool
+	}{
+		{
+			name: "germany",
+			bounds: proj.Bounds{
+				XMin: 5.87,
+				YMin: 47.27,
+				XMax: 15.04,
+				YMax: 55.06,
+			},
+			expectedZone: 32,
+		},
+		{
+			name: "australia",
+			bounds: proj.Bounds{
+				XMin: 113,
+				YMin: -44,
+				XMax: 154,
+				YMax: -10,
+			},
+			expectedZone:       53,
+			expectedHemisphere: utm.HemisphereSouth,
+		},
+		{
+			name: "norway_exception",
+			bounds: proj.Bounds{
+				XMin: 4,
+				YMin: 58,
+				XMax: 6,
+				YMax: 62,
+			},
+			expectedZone: 32,
+		},
+		{
+			name: "antimeridian",
+			bounds: proj.Bounds{
+				XMin: 170,
+				YMin: -20,
+				XMax: -176,
+				YMax: -10,
+			},
+			expectedZone:       60,
+			expectedHemisphere: utm.HemisphereSouth,
+		},
+		{
+			name: "polar",
+			bounds: proj.Bounds{
+				XMin: -180,
+				YMin: 80,
+				XMax: 180,
+				YMax: 90,
+			},
+			expectedErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			zone, hemisphere, err := utm.ZoneForBounds(tc.bounds)
+			if tc.expectedErr {
+				var polarError *utm.PolarError
+				assert.True(t, errors.As(err, &polarError))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedZone, zone)
+			assert.Equal(t, tc.expectedHemisphere, hemisphere)
+		})
+	}
+}
+
+func TestZoneForCoords(t *testing.T) {
+	for _, tc := range []struct {
+		name               string
+		coords             []proj.Coord
+		expectedZone       int
+		expectedHemisphere utm.Hemisphere
+		expectedErr        bool
+	}{
+		{
+			name:        "empty",
+			expectedErr: true,
+		},
+		{
+			name: "majority",
+			coords: []proj.Coord{
+				proj.NewCoord(5, 45, 0, 0),
+				proj.NewCoord(7, 45, 0, 0),
+				proj.NewCoord(8, 45, 0, 0),
+			},
+			expectedZone: 32,
+		},
+		{
+			name: "tie",
+			coords: []proj.Coord{
+				proj.NewCoord(7, -1, 0, 0),
+				proj.NewCoord(5, 1, 0, 0),
+			},
+			expectedZone: 31,
+		},
+		{
+			name: "south",
+			coords: []proj.Coord{
+				proj.NewCoord(151, -33, 0, 0),
+				proj.NewCoord(151, -34, 0, 0),
+				proj.NewCoord(151, 1, 0, 0),
+			},
+			expectedZone:       56,
+			expectedHemisphere: utm.HemisphereSouth,
+		},
+		{
+			name: "polar",
+			coords: []proj.Coord{
+				proj.NewCoord(0, 0, 0, 0),
+				proj.NewCoord(0, -85, 0, 0),
+			},
+			expectedErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			zone, hemisphere, err := utm.ZoneForCoords(tc.coords)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedZone, zone)
+			assert.Equal(t, tc.expectedHemisphere, hemisphere)
+		})
+	}
+}
+
 func assertWithin(tb testing.TB, maxDelta, actual, expected float64) {
 	tb.Helper()
 	delta := math.Abs(expected - actual)